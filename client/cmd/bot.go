@@ -0,0 +1,166 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/bot"
+	"github.com/movaua/rock-paper-scissors/pkg/client"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// botCmd represents the bot command
+var botCmd = &cobra.Command{
+	Use:   "bot",
+	Short: "Plays Rock Paper Scissors game on the game server by a bot",
+	RunE:  runBot,
+}
+
+func init() {
+	rootCmd.AddCommand(botCmd)
+
+	botCmd.Flags().String("strategy", "markov", fmt.Sprintf("strategy of the bot, one of %v", bot.StrategyNames()))
+
+	if err := viper.BindPFlag("strategy", botCmd.Flags().Lookup("strategy")); err != nil {
+		panic(err)
+	}
+}
+
+func runBot(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	st, err := bot.NewStrategy(viper.GetString("strategy"), newRand())
+	if err != nil {
+		return err
+	}
+
+	session, err := dial()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	ctx := context.Background()
+
+	fmt.Printf("%s plays with the %s strategy, waiting for the other players...\n", session.Player().GetName(), st.Name())
+	ready, err := session.Play(ctx)
+	if err != nil {
+		return err
+	}
+
+	over, err := bot.Play(ctx, session, st, ready.GetWeapons())
+	if err != nil {
+		return fmt.Errorf("game is interrupted: %w", err)
+	}
+
+	fmt.Printf("Game over. %s\n", resultText(over.Result))
+	return nil
+}
+
+// seatBots connects the bot players of the user's session to the room
+// and plays their games in the background until the games are over or the context is done.
+// The bots are named after the user and owned by the user.
+func seatBots(ctx context.Context, owner *client.Session, n int, strategy string) error {
+	secret, err := botSecret()
+	if err != nil {
+		return err
+	}
+
+	for i := 1; i <= n; i++ {
+		st, err := bot.NewStrategy(strategy, newRand())
+		if err != nil {
+			return err
+		}
+
+		name := fmt.Sprintf("%s-bot-%d", owner.Player().GetName(), i)
+		session, err := dialBot(name, botKey(secret, name), owner.Token())
+		if err != nil {
+			return fmt.Errorf("cannot seat bot: %w", err)
+		}
+
+		go func() {
+			defer session.Close()
+
+			ready, err := session.Play(ctx)
+			if err != nil {
+				return
+			}
+			_, _ = bot.Play(ctx, session, st, ready.GetWeapons())
+		}()
+	}
+	return nil
+}
+
+// botSecret returns the secret the keys of the user's bots are derived from,
+// it is generated in the bot secret file set by the config on the first call.
+func botSecret() ([]byte, error) {
+	path := viper.GetString("bot-secret")
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("cannot find bot secret: %w", err)
+		}
+		path = filepath.Join(home, ".client-bots.key")
+	}
+
+	secret, err := ioutil.ReadFile(path)
+	if err == nil {
+		if len(secret) < ed25519.SeedSize {
+			return nil, fmt.Errorf("bot secret %s must be at least %d bytes, got %d", path, ed25519.SeedSize, len(secret))
+		}
+		return secret, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("cannot read bot secret: %w", err)
+	}
+
+	secret = make([]byte, ed25519.SeedSize)
+	if _, err := crand.Read(secret); err != nil {
+		return nil, fmt.Errorf("cannot generate bot secret: %w", err)
+	}
+	if err := ioutil.WriteFile(path, secret, 0600); err != nil {
+		return nil, fmt.Errorf("cannot save bot secret: %w", err)
+	}
+	return secret, nil
+}
+
+// botKey derives the key of the bot with the name from the secret,
+// so that the bot can reconnect with the same key every time.
+func botKey(secret []byte, name string) ed25519.PrivateKey {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(name))
+	return ed25519.NewKeyFromSeed(mac.Sum(nil))
+}
+
+// newRand returns a new source of random numbers seeded by the current time.
+func newRand() *rand.Rand {
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
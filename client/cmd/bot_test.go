@@ -0,0 +1,72 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestBotSecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bots")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "bots.key")
+	viper.Set("bot-secret", path)
+	defer viper.Set("bot-secret", "")
+
+	secret, err := botSecret()
+	if err != nil {
+		t.Fatalf("botSecret() of a new secret = %v", err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("saved secret = %v, %v, want mode 0600", info, err)
+	}
+	again, err := botSecret()
+	if err != nil || !bytes.Equal(again, secret) {
+		t.Errorf("botSecret() again = %x, %v, want the saved %x", again, err, secret)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("short"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := botSecret(); err == nil {
+		t.Error("botSecret() of a short secret = nil error")
+	}
+}
+
+func TestBotKey(t *testing.T) {
+	secret := bytes.Repeat([]byte{1}, 32)
+	key := botKey(secret, "alice-bot-1")
+	if !key.Equal(botKey(secret, "alice-bot-1")) {
+		t.Error("botKey() differs for the same bot")
+	}
+	if key.Equal(botKey(secret, "alice-bot-2")) {
+		t.Error("botKey() is the same for different bots")
+	}
+	if key.Equal(botKey(bytes.Repeat([]byte{2}, 32), "alice-bot-1")) {
+		t.Error("botKey() is the same for different secrets")
+	}
+}
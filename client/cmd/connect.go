@@ -0,0 +1,127 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/client"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/credentials"
+)
+
+// dialTimeout is the time to wait for the connection to the server and the authentication.
+const dialTimeout = 10 * time.Second
+
+// dial connects to the game server set by the config and authenticates the player.
+func dial() (*client.Session, error) {
+	opts := []client.Option{
+		client.WithPassword(viper.GetString("password")),
+		client.WithRoom(viper.GetString("room")),
+	}
+	if viper.GetBool("reconnect") {
+		opts = append(opts, client.WithReconnect())
+	}
+
+	cfg, err := clientTLS()
+	if err != nil {
+		return nil, err
+	}
+	if cfg != nil {
+		opts = append(opts, client.WithTransportCredentials(credentials.NewTLS(cfg)))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+
+	return client.Dial(ctx, viper.GetString("server"), viper.GetString("name"), opts...)
+}
+
+// dialBot connects a bot player with the name and the key to the game server set by the config.
+// The bot registers as a bot owned by the player the owner token is issued to
+// or reconnects as the bot registered with the name before.
+// It connects with the user's client certificate, which proves the ownership
+// on a server requiring client certificates.
+func dialBot(name string, key ed25519.PrivateKey, ownerToken string) (*client.Session, error) {
+	opts := []client.Option{
+		client.WithReconnect(),
+		client.WithKey(key),
+		client.WithOwner(ownerToken),
+		client.WithRoom(viper.GetString("room")),
+	}
+
+	cfg, err := clientTLS()
+	if err != nil {
+		return nil, err
+	}
+	if cfg != nil {
+		opts = append(opts, client.WithTransportCredentials(credentials.NewTLS(cfg)))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+
+	return client.Dial(ctx, viper.GetString("server"), name, opts...)
+}
+
+// clientTLS returns the TLS config of the client set by the config
+// or nil if the connection is plaintext.
+// The server certificate is verified by the CA if it is set or by the system roots otherwise.
+func clientTLS() (*tls.Config, error) {
+	caFile := viper.GetString("tls-ca")
+	certFile := viper.GetString("tls-cert")
+	keyFile := viper.GetString("tls-key")
+
+	if !viper.GetBool("tls") && caFile == "" && certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile != "" {
+		pem, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read server CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates are found in server CA %s", caFile)
+		}
+		cfg.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, fmt.Errorf("both TLS certificate and key must be set")
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load TLS certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/client"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// statusNames are the words the results are shown with.
+var statusNames = map[pb.EnumStatus]string{
+	pb.EnumStatus_UnknownStatus: "-",
+	pb.EnumStatus_Winner:        "winner",
+	pb.EnumStatus_Looser:        "loser",
+	pb.EnumStatus_Draw:          "draw",
+}
+
+// lineView is the line-based view of the game:
+// it reads the choises line by line and prints the prompt with the time left to choose.
+// The countdown goes on new lines not to overwrite what the user is typing.
+type lineView struct {
+	in      io.Reader
+	out     io.Writer
+	id      string
+	arsenal *arsenal
+
+	chosen    bool
+	deadline  time.Time
+	announced int // seconds left shown last in the round
+}
+
+// countdownInterval is the interval in seconds of the countdown in the line view,
+// the last countdownFinal seconds are counted every second.
+const (
+	countdownInterval = 10
+	countdownFinal    = 5
+)
+
+// input implements view.
+// The game goes on without the choises of the user at the end of the input.
+func (v *lineView) input() <-chan string {
+	lines := make(chan string)
+	go func() {
+		s := bufio.NewScanner(v.in)
+		for s.Scan() {
+			lines <- s.Text()
+		}
+	}()
+	return lines
+}
+
+// roundStarted implements view.
+func (v *lineView) roundStarted(deadline time.Time) {
+	v.chosen = false
+	v.deadline = deadline
+	v.announced = secondsLeft(deadline)
+	fmt.Fprintf(v.out, "\nChoose your weapon: %s\n", v.arsenal.help())
+	v.prompt()
+}
+
+// tick implements view.
+// The prompt with the time left is shown again on a new line every countdownInterval seconds
+// and every second of the last countdownFinal ones, then the user is told the time is up.
+func (v *lineView) tick() {
+	left := secondsLeft(v.deadline)
+	if v.chosen || left == v.announced {
+		return
+	}
+
+	switch {
+	case left == 0:
+		v.announced = left
+		fmt.Fprintln(v.out, "\ntime's up")
+	case left%countdownInterval == 0 || left <= countdownFinal:
+		v.announced = left
+		fmt.Fprintln(v.out)
+		v.prompt()
+	}
+}
+
+// prompt shows the time left to choose.
+func (v *lineView) prompt() {
+	fmt.Fprintf(v.out, "%ds left > ", secondsLeft(v.deadline))
+}
+
+// chose implements view.
+func (v *lineView) chose(c pb.EnumChoise) {
+	v.chosen = true
+	fmt.Fprintf(v.out, "you chose %s, waiting for the other players\n", v.arsenal.name(c))
+}
+
+// notice implements view.
+func (v *lineView) notice(msg string) {
+	fmt.Fprintln(v.out, msg)
+	if !v.chosen {
+		v.prompt()
+	}
+}
+
+// scored implements view.
+func (v *lineView) scored(e client.RoundEnded) {
+	fmt.Fprintf(v.out, "\nRound %d\n", e.Round)
+	tw := tabwriter.NewWriter(v.out, 0, 0, 2, ' ', 0)
+	for _, r := range e.Results {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", playerName(r.GetPlayer(), v.id), v.arsenal.name(r.GetChoise()), statusNames[r.GetStatus()])
+	}
+	tw.Flush()
+
+	fmt.Fprintln(v.out, "Game")
+	tw = tabwriter.NewWriter(v.out, 0, 0, 2, ' ', 0)
+	for _, r := range e.Game {
+		fmt.Fprintf(tw, "  %s\t%d\t%s\n", playerName(r.GetPlayer(), v.id), r.GetScore(), statusNames[r.GetStatus()])
+	}
+	tw.Flush()
+}
+
+// connection implements view.
+func (v *lineView) connection(state string) {
+	fmt.Fprintf(v.out, "\n%s\n", state)
+}
+
+// gameOver implements view.
+func (v *lineView) gameOver(result pb.EnumStatus) {
+	fmt.Fprintf(v.out, "\nGame over\n%s\n", resultText(result))
+}
+
+// secondsLeft returns the number of the whole seconds left until the deadline, 0 after it.
+func secondsLeft(deadline time.Time) int {
+	left := time.Until(deadline).Round(time.Second)
+	if left < 0 {
+		return 0
+	}
+	return int(left.Seconds())
+}
+
+// playerName returns the name of the player marking the user.
+func playerName(pl *pb.Player, userID string) string {
+	if pl.GetId() == userID {
+		return pl.GetName() + " (you)"
+	}
+	return pl.GetName()
+}
+
+// resultText describes the user's result of the game.
+func resultText(result pb.EnumStatus) string {
+	switch result {
+	case pb.EnumStatus_Winner:
+		return "You won!"
+	case pb.EnumStatus_Looser:
+		return "You lost."
+	case pb.EnumStatus_Draw:
+		return "It's a draw."
+	}
+	return ""
+}
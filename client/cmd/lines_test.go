@@ -0,0 +1,119 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+func newTestLineView(in string) (*lineView, *strings.Builder) {
+	out := &strings.Builder{}
+	return &lineView{
+		in:      strings.NewReader(in),
+		out:     out,
+		id:      alice.GetId(),
+		arsenal: newTestArsenal(),
+	}, out
+}
+
+func TestLineViewPrompt(t *testing.T) {
+	v, out := newTestLineView("")
+
+	v.roundStarted(time.Now().Add(20 * time.Second))
+	if got, want := out.String(), "\nChoose your weapon: [r]Stone [s]Scissors [p]Paper\n20s left > "; got != want {
+		t.Errorf("round start = %q, want %q", got, want)
+	}
+
+	out.Reset()
+	v.notice("unknown weapon")
+	if got, want := out.String(), "unknown weapon\n20s left > "; got != want {
+		t.Errorf("notice = %q, want %q", got, want)
+	}
+
+	out.Reset()
+	v.chose(pb.EnumChoise_Paper)
+	v.notice("you have already chosen")
+	if got, want := out.String(), "you chose Paper, waiting for the other players\nyou have already chosen\n"; got != want {
+		t.Errorf("notice after the choise = %q, want %q", got, want)
+	}
+}
+
+func TestLineViewCountdown(t *testing.T) {
+	v, out := newTestLineView("")
+	v.roundStarted(time.Now().Add(20 * time.Second))
+
+	steps := []struct {
+		left time.Duration
+		want string
+	}{
+		{20 * time.Second, ""},
+		{15 * time.Second, ""},
+		{10 * time.Second, "\n10s left > "},
+		{10 * time.Second, ""},
+		{7 * time.Second, ""},
+		{5 * time.Second, "\n5s left > "},
+		{4 * time.Second, "\n4s left > "},
+		{-time.Second, "\ntime's up\n"},
+		{-2 * time.Second, ""},
+	}
+	for _, st := range steps {
+		out.Reset()
+		v.deadline = time.Now().Add(st.left)
+		v.tick()
+		if got := out.String(); got != st.want {
+			t.Errorf("tick() with %v left = %q, want %q", st.left, got, st.want)
+		}
+	}
+
+	v.roundStarted(time.Now().Add(20 * time.Second))
+	v.chose(pb.EnumChoise_Stone)
+	out.Reset()
+	v.deadline = time.Now().Add(10 * time.Second)
+	v.tick()
+	if got := out.String(); got != "" {
+		t.Errorf("tick() after the choise = %q, want nothing", got)
+	}
+}
+
+func TestLineViewInput(t *testing.T) {
+	v, _ := newTestLineView("r\n2\n PAPER \nrock\n9\n\n")
+
+	wants := []struct {
+		choise pb.EnumChoise
+		ok     bool
+	}{
+		{pb.EnumChoise_Stone, true},
+		{pb.EnumChoise_Scissors, true},
+		{pb.EnumChoise_Paper, true},
+		{pb.EnumChoise_UnknownChoise, false},
+		{pb.EnumChoise_UnknownChoise, false},
+		{pb.EnumChoise_UnknownChoise, false},
+	}
+
+	input := v.input()
+	for _, want := range wants {
+		line := <-input
+		if c, ok := v.arsenal.pick(line); c != want.choise || ok != want.ok {
+			t.Errorf("pick(%q) = %v, %v, want %v, %v", line, c, ok, want.choise, want.ok)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/bot"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// gameOverPause is the time the result of the game stays on the full screen.
+const gameOverPause = 3 * time.Second
+
+// playCmd represents the play command
+var playCmd = &cobra.Command{
+	Use:   "play",
+	Short: "Plays Rock Paper Scissors game on the game server",
+	RunE:  play,
+}
+
+func init() {
+	rootCmd.AddCommand(playCmd)
+
+	playCmd.Flags().Bool("tui", false, "play in the full-screen terminal UI")
+	playCmd.Flags().Int("bots", 0, "number of bot players to seat in the room")
+	playCmd.Flags().String("bot-strategy", "markov", fmt.Sprintf("strategy of the bot players, one of %v", bot.StrategyNames()))
+	playCmd.Flags().String("bot-secret", "", "file of the secret the keys of the bot players are derived from, created if it does not exist (default is $HOME/.client-bots.key)")
+
+	for _, name := range []string{"tui", "bots", "bot-strategy", "bot-secret"} {
+		if err := viper.BindPFlag(name, playCmd.Flags().Lookup(name)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func play(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	session, err := dial()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	me := session.Player()
+	fmt.Printf("Hello, %s! Your rating is %.0f.\n", me.GetName(), me.GetRating())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if n := viper.GetInt("bots"); n > 0 {
+		if err := seatBots(ctx, session, n, viper.GetString("bot-strategy")); err != nil {
+			return err
+		}
+	}
+
+	fmt.Println("Waiting for the other players...")
+	ready, err := session.Play(ctx)
+	if err != nil {
+		return err
+	}
+
+	p := &player{
+		session: session,
+		arsenal: newArsenal(ready.GetWeapons()),
+	}
+
+	if !viper.GetBool("tui") {
+		fmt.Printf("The game starts: %s ruleset, %d seconds to choose.\n", ready.GetRuleset(), ready.GetChoiseTimeoutSeconds())
+		if ready.GetFairPlay() {
+			fmt.Println("Fair play: the choises are committed before they are revealed.")
+		}
+		p.view = &lineView{
+			in:      os.Stdin,
+			out:     os.Stdout,
+			id:      me.GetId(),
+			arsenal: p.arsenal,
+		}
+		return p.run(ctx)
+	}
+
+	title := fmt.Sprintf("Rock Paper Scissors · %s · %s ruleset", me.GetName(), ready.GetRuleset())
+	if ready.GetFairPlay() {
+		title += " · fair play"
+	}
+
+	term, err := openTerminal()
+	if err != nil {
+		return err
+	}
+	s := &screen{
+		term:    term,
+		title:   title,
+		id:      me.GetId(),
+		arsenal: p.arsenal,
+		state:   "connected",
+	}
+	p.view = s
+
+	err = p.run(ctx)
+	if err == nil && s.over {
+		// let the user see the result of the game
+		time.Sleep(gameOverPause)
+	}
+	if cerr := term.close(); err == nil {
+		err = cerr
+	}
+	return err
+}
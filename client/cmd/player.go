@@ -0,0 +1,133 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/client"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// view shows the game to the user and takes the user's input.
+type view interface {
+	// input returns the choises the user makes, it is closed when the user quits.
+	input() <-chan string
+
+	// roundStarted prompts for the choise of the round until the deadline.
+	roundStarted(deadline time.Time)
+
+	// tick updates the countdown of the round every second, if the view shows one.
+	tick()
+
+	// chose shows the choise the user has made.
+	chose(c pb.EnumChoise)
+
+	// notice shows a message to the user.
+	notice(msg string)
+
+	// scored shows the round results and the game results.
+	scored(e client.RoundEnded)
+
+	// connection shows the state of the connection to the server.
+	connection(state string)
+
+	// gameOver shows the user's result of the game.
+	gameOver(result pb.EnumStatus)
+}
+
+// player plays the game for the user in the session:
+// it takes the choises from the view and shows the events of the game in the view.
+type player struct {
+	session *client.Session
+	arsenal *arsenal
+	view    view
+	chosen  bool
+}
+
+// run plays the game until it is over, the session fails or the user quits.
+func (p *player) run(ctx context.Context) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	input := p.view.input()
+	events := p.session.Events()
+
+	for {
+		select {
+		case line, ok := <-input:
+			if !ok {
+				return nil
+			}
+			p.choose(ctx, line)
+		case <-ticker.C:
+			p.view.tick()
+		case e, ok := <-events:
+			if !ok {
+				if err := p.session.Err(); err != nil {
+					return fmt.Errorf("game is interrupted: %w", err)
+				}
+				return nil
+			}
+			p.show(e)
+		}
+	}
+}
+
+// choose makes the choise picked by the input.
+func (p *player) choose(ctx context.Context, input string) {
+	if p.chosen {
+		p.view.notice("you have already chosen, waiting for the other players")
+		return
+	}
+	c, ok := p.arsenal.pick(input)
+	if !ok {
+		p.view.notice(fmt.Sprintf("unknown weapon %q, choose one of %s", input, p.arsenal.help()))
+		return
+	}
+
+	if err := p.session.Choose(ctx, c); err != nil {
+		p.view.notice(err.Error())
+		return
+	}
+
+	p.chosen = true
+	p.view.chose(c)
+}
+
+// show shows the event of the game.
+func (p *player) show(e client.Event) {
+	switch e := e.(type) {
+	case client.RoundStarted:
+		p.chosen = false
+		p.view.roundStarted(e.Deadline)
+	case client.RoundEnded:
+		p.view.scored(e)
+		for _, pl := range e.Mismatched {
+			p.view.notice(fmt.Sprintf("warning: the choise of %s does not match the commitment", pl.GetName()))
+		}
+	case client.Reconnecting:
+		p.view.connection(fmt.Sprintf("reconnecting, attempt %d", e.Attempt))
+	case client.Reconnected:
+		p.view.connection("connected")
+	case client.GameOver:
+		p.view.gameOver(e.Result)
+	}
+}
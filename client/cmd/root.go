@@ -0,0 +1,93 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package cmd defines commands which client can do.
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/spf13/viper"
+)
+
+var cfgFile string
+
+// rootCmd represents the base command when called without any subcommands
+var rootCmd = &cobra.Command{
+	Use:   "client",
+	Short: "Rock Paper Scissors game client",
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	cobra.OnInitialize(initConfig)
+
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.client.yaml)")
+
+	rootCmd.PersistentFlags().StringP("server", "s", "localhost:9090", "game server address")
+	rootCmd.PersistentFlags().StringP("name", "n", "", "player name, the common name of the client certificate is used if it is empty")
+	rootCmd.PersistentFlags().String("password", "", "player password to register with or to reconnect with")
+	rootCmd.PersistentFlags().Bool("reconnect", false, "reconnect as the player registered with the name before")
+	rootCmd.PersistentFlags().String("room", "", "ID of the room to play in, the default room if it is empty")
+	rootCmd.PersistentFlags().Bool("tls", false, "connect with TLS verifying the server by the system roots")
+	rootCmd.PersistentFlags().String("tls-ca", "", "CA certificate file to verify the server, implies TLS")
+	rootCmd.PersistentFlags().String("tls-cert", "", "client certificate file, implies TLS")
+	rootCmd.PersistentFlags().String("tls-key", "", "client private key file")
+
+	for _, name := range []string{"server", "name", "password", "reconnect", "room", "tls", "tls-ca", "tls-cert", "tls-key"} {
+		if err := viper.BindPFlag(name, rootCmd.PersistentFlags().Lookup(name)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// initConfig reads in config file and ENV variables if set.
+func initConfig() {
+	if cfgFile != "" {
+		// Use config file from the flag.
+		viper.SetConfigFile(cfgFile)
+	} else {
+		// Find home directory.
+		home, err := os.UserHomeDir()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		// Search config in home directory with name ".client" (without extension).
+		viper.AddConfigPath(home)
+		viper.SetConfigName(".client")
+	}
+
+	viper.AutomaticEnv() // read in environment variables that match
+
+	// If a config file is found, read it in.
+	if err := viper.ReadInConfig(); err == nil {
+		fmt.Println("Using config file:", viper.ConfigFileUsed())
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/movaua/rock-paper-scissors/pkg/client"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// Keys which quit the full-screen view.
+const (
+	keyQuit  = 'q'
+	keyCtrlC = 3
+)
+
+// terminal is a full-screen terminal the screen is drawn on.
+type terminal interface {
+	// size returns the number of the columns and the rows of the terminal.
+	size() (int, int)
+
+	// draw replaces the content of the terminal with the lines.
+	draw(lines []string) error
+
+	// keys returns the keys pressed by the user, it is closed when the terminal is closed.
+	keys() <-chan rune
+
+	// done is closed when the terminal is closed.
+	done() <-chan struct{}
+}
+
+// screen is the full-screen view of the game: the scoreboard, the history of the rounds,
+// the countdown of the current round and the state of the connection.
+type screen struct {
+	term    terminal
+	title   string
+	id      string
+	arsenal *arsenal
+
+	scoreboard []*pb.GameResult
+	history    []string // descriptions of the played rounds, the latest first
+	deadline   time.Time
+	chosen     string
+	message    string
+	state      string
+	over       bool
+}
+
+// input implements view.
+// The keys pick the weapons, q and Ctrl+C quit. The input stops when the terminal is closed.
+func (s *screen) input() <-chan string {
+	choises := make(chan string)
+	go func() {
+		defer close(choises)
+		for k := range s.term.keys() {
+			if k == keyQuit || k == keyCtrlC {
+				return
+			}
+			select {
+			case choises <- string(k):
+			case <-s.term.done():
+				return
+			}
+		}
+	}()
+	return choises
+}
+
+// roundStarted implements view.
+func (s *screen) roundStarted(deadline time.Time) {
+	s.deadline = deadline
+	s.chosen = ""
+	s.redraw()
+}
+
+// tick implements view.
+func (s *screen) tick() {
+	s.redraw()
+}
+
+// chose implements view.
+func (s *screen) chose(c pb.EnumChoise) {
+	s.chosen = s.arsenal.name(c)
+	s.message = ""
+	s.redraw()
+}
+
+// notice implements view.
+func (s *screen) notice(msg string) {
+	s.message = msg
+	s.redraw()
+}
+
+// scored implements view.
+func (s *screen) scored(e client.RoundEnded) {
+	s.scoreboard = e.Game
+
+	parts := make([]string, 0, len(e.Results))
+	for _, r := range e.Results {
+		parts = append(parts, fmt.Sprintf("%s %s (%s)", playerName(r.GetPlayer(), s.id), s.arsenal.name(r.GetChoise()), statusNames[r.GetStatus()]))
+	}
+	round := fmt.Sprintf("%3d  %s", e.Round, strings.Join(parts, ", "))
+	s.history = append([]string{round}, s.history...)
+
+	s.message = ""
+	s.redraw()
+}
+
+// connection implements view.
+func (s *screen) connection(state string) {
+	s.state = state
+	s.redraw()
+}
+
+// gameOver implements view.
+func (s *screen) gameOver(result pb.EnumStatus) {
+	s.over = true
+	s.message = "Game over. " + resultText(result)
+	s.redraw()
+}
+
+// redraw draws the screen on the terminal.
+func (s *screen) redraw() {
+	width, height := s.term.size()
+
+	var lines []string
+	lines = append(lines, spread(s.title, s.state, width))
+	rule := strings.Repeat("─", width)
+
+	lines = append(lines, rule, "Scoreboard")
+	board := make([][]string, 0, len(s.scoreboard))
+	for _, r := range s.scoreboard {
+		board = append(board, []string{playerName(r.GetPlayer(), s.id), fmt.Sprint(r.GetScore()), statusNames[r.GetStatus()]})
+	}
+	lines = append(lines, columns(board)...)
+
+	var footer []string
+	switch {
+	case s.over:
+	case s.chosen != "":
+		footer = append(footer, fmt.Sprintf("You chose %s, waiting for the other players", s.chosen))
+	default:
+		footer = append(footer, fmt.Sprintf("%3ds  Choose your weapon: %s", secondsLeft(s.deadline), s.arsenal.help()))
+	}
+	if s.message != "" {
+		footer = append(footer, s.message)
+	}
+	footer = append(footer, "[q] quit")
+
+	lines = append(lines, rule, "History")
+	room := height - len(lines) - len(footer) - 1
+	for i, h := range s.history {
+		if i >= room {
+			break
+		}
+		lines = append(lines, h)
+	}
+	for len(lines) < height-len(footer)-1 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, rule)
+	lines = append(lines, footer...)
+
+	for i, l := range lines {
+		lines[i] = truncate(l, width)
+	}
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+
+	_ = s.term.draw(lines)
+}
+
+// spread places the left text at the left edge and the right one at the right edge of the line.
+func spread(left, right string, width int) string {
+	gap := width - utf8.RuneCountInString(left) - utf8.RuneCountInString(right)
+	if gap < 1 {
+		gap = 1
+	}
+	return left + strings.Repeat(" ", gap) + right
+}
+
+// columns aligns the cells of the rows in columns.
+func columns(rows [][]string) []string {
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			if n := utf8.RuneCountInString(cell); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		var b strings.Builder
+		b.WriteString(" ")
+		for i, cell := range row {
+			b.WriteString(" ")
+			b.WriteString(cell)
+			b.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)+1))
+		}
+		lines = append(lines, strings.TrimRight(b.String(), " "))
+	}
+	return lines
+}
+
+// truncate cuts the line to the width.
+func truncate(line string, width int) string {
+	if utf8.RuneCountInString(line) <= width {
+		return line
+	}
+	return string([]rune(line)[:width])
+}
@@ -0,0 +1,326 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/client"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// fakeTerminal is a terminal of a fixed size which records the drawn screens
+// and presses the scripted keys.
+type fakeTerminal struct {
+	width, height int
+	screens       [][]string
+	pressed       chan rune
+	closed        chan struct{}
+}
+
+func newFakeTerminal(width, height int, keys ...rune) *fakeTerminal {
+	t := &fakeTerminal{
+		width:   width,
+		height:  height,
+		pressed: make(chan rune, len(keys)),
+		closed:  make(chan struct{}),
+	}
+	for _, k := range keys {
+		t.pressed <- k
+	}
+	close(t.pressed)
+	return t
+}
+
+func (t *fakeTerminal) size() (int, int) {
+	return t.width, t.height
+}
+
+func (t *fakeTerminal) draw(lines []string) error {
+	t.screens = append(t.screens, append([]string(nil), lines...))
+	return nil
+}
+
+func (t *fakeTerminal) keys() <-chan rune {
+	return t.pressed
+}
+
+func (t *fakeTerminal) done() <-chan struct{} {
+	return t.closed
+}
+
+// last returns the last drawn screen.
+func (t *fakeTerminal) last() []string {
+	if len(t.screens) == 0 {
+		return nil
+	}
+	return t.screens[len(t.screens)-1]
+}
+
+var (
+	alice = &pb.Player{Id: "1", Name: "alice"}
+	bob   = &pb.Player{Id: "2", Name: "bob"}
+)
+
+// newTestArsenal returns the arsenal of the classic weapons.
+func newTestArsenal() *arsenal {
+	return newArsenal([]*pb.Weapon{
+		{Choise: pb.EnumChoise_Stone, Name: "Stone"},
+		{Choise: pb.EnumChoise_Scissors, Name: "Scissors"},
+		{Choise: pb.EnumChoise_Paper, Name: "Paper"},
+	})
+}
+
+func newTestScreen(term terminal) *screen {
+	return &screen{
+		term:    term,
+		title:   "Rock Paper Scissors",
+		id:      alice.GetId(),
+		arsenal: newTestArsenal(),
+		state:   "connected",
+	}
+}
+
+func TestScreenScoreboard(t *testing.T) {
+	term := newFakeTerminal(40, 12)
+	s := newTestScreen(term)
+
+	s.scored(client.RoundEnded{
+		Round: 1,
+		Game: []*pb.GameResult{
+			{Player: alice, Score: 1, Status: pb.EnumStatus_Winner},
+			{Player: bob, Score: 0, Status: pb.EnumStatus_Looser},
+		},
+	})
+
+	got := term.last()
+	if len(got) != 12 {
+		t.Fatalf("drawn %d lines, want 12: %q", len(got), got)
+	}
+	want := []string{
+		"Rock Paper Scissors            connected",
+		strings.Repeat("─", 40),
+		"Scoreboard",
+		"  alice (you)  1  winner",
+		"  bob          0  loser",
+	}
+	if !reflect.DeepEqual(got[:len(want)], want) {
+		t.Errorf("screen starts with\n%q\nwant\n%q", got[:len(want)], want)
+	}
+	if l := got[len(got)-1]; l != "[q] quit" {
+		t.Errorf("last line = %q, want %q", l, "[q] quit")
+	}
+}
+
+func TestScreenHistory(t *testing.T) {
+	tests := []struct {
+		name   string
+		height int
+		want   []string
+	}{
+		{
+			name:   "all rounds",
+			height: 10,
+			want: []string{
+				"  2  alice (you) Paper (winner), bob Stone (loser)",
+				"  1  alice (you) Stone (draw), bob Stone (draw)",
+			},
+		},
+		{
+			name:   "latest rounds fit",
+			height: 9,
+			want: []string{
+				"  2  alice (you) Paper (winner), bob Stone (loser)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			term := newFakeTerminal(60, tt.height)
+			s := newTestScreen(term)
+
+			s.scored(client.RoundEnded{
+				Round: 1,
+				Results: []*pb.RoundResult{
+					{Player: alice, Choise: pb.EnumChoise_Stone, Status: pb.EnumStatus_Draw},
+					{Player: bob, Choise: pb.EnumChoise_Stone, Status: pb.EnumStatus_Draw},
+				},
+			})
+			s.scored(client.RoundEnded{
+				Round: 2,
+				Results: []*pb.RoundResult{
+					{Player: alice, Choise: pb.EnumChoise_Paper, Status: pb.EnumStatus_Winner},
+					{Player: bob, Choise: pb.EnumChoise_Stone, Status: pb.EnumStatus_Looser},
+				},
+			})
+
+			got := history(term.last())
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("history = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScreenCountdown(t *testing.T) {
+	term := newFakeTerminal(60, 12)
+	s := newTestScreen(term)
+
+	s.roundStarted(time.Now().Add(10 * time.Second))
+	if got, want := footer(term.last()), " 10s  Choose your weapon: [r]Stone [s]Scissors [p]Paper"; got != want {
+		t.Errorf("footer = %q, want %q", got, want)
+	}
+
+	s.deadline = time.Now().Add(3 * time.Second)
+	s.tick()
+	if got, want := footer(term.last()), "  3s  Choose your weapon: [r]Stone [s]Scissors [p]Paper"; got != want {
+		t.Errorf("footer after tick = %q, want %q", got, want)
+	}
+
+	s.deadline = time.Now().Add(-time.Second)
+	s.tick()
+	if got, want := footer(term.last()), "  0s  Choose your weapon: [r]Stone [s]Scissors [p]Paper"; got != want {
+		t.Errorf("footer after the deadline = %q, want %q", got, want)
+	}
+}
+
+func TestScreenInput(t *testing.T) {
+	term := newFakeTerminal(60, 12, 'r', 'p', 's', 'x', keyQuit, 'r')
+	s := newTestScreen(term)
+
+	wants := []struct {
+		key    string
+		choise pb.EnumChoise
+		ok     bool
+		footer string
+	}{
+		{"r", pb.EnumChoise_Stone, true, "You chose Stone, waiting for the other players"},
+		{"p", pb.EnumChoise_Paper, true, "You chose Paper, waiting for the other players"},
+		{"s", pb.EnumChoise_Scissors, true, "You chose Scissors, waiting for the other players"},
+		{"x", pb.EnumChoise_UnknownChoise, false, ""},
+	}
+
+	input := s.input()
+	for _, want := range wants {
+		key, ok := <-input
+		if !ok {
+			t.Fatalf("input is closed, want %q", want.key)
+		}
+		if key != want.key {
+			t.Fatalf("input = %q, want %q", key, want.key)
+		}
+
+		c, ok := s.arsenal.pick(key)
+		if c != want.choise || ok != want.ok {
+			t.Errorf("pick(%q) = %v, %v, want %v, %v", key, c, ok, want.choise, want.ok)
+		}
+		if !ok {
+			continue
+		}
+		s.roundStarted(time.Now().Add(10 * time.Second))
+		s.chose(c)
+		if got := footer(term.last()); got != want.footer {
+			t.Errorf("footer = %q, want %q", got, want.footer)
+		}
+	}
+
+	if key, ok := <-input; ok {
+		t.Errorf("input = %q after q, want it closed", key)
+	}
+}
+
+func TestScreenQuitCtrlC(t *testing.T) {
+	term := newFakeTerminal(60, 12, keyCtrlC, 'r')
+	s := newTestScreen(term)
+
+	if key, ok := <-s.input(); ok {
+		t.Errorf("input = %q after Ctrl+C, want it closed", key)
+	}
+}
+
+func TestScreenInputStops(t *testing.T) {
+	// the user keeps the keys open, the game does not take the pressed key
+	term := &fakeTerminal{width: 60, height: 12, pressed: make(chan rune, 1), closed: make(chan struct{})}
+	term.pressed <- 'r'
+	s := newTestScreen(term)
+
+	input := s.input()
+	time.Sleep(10 * time.Millisecond)
+	close(term.closed)
+
+	timeout := time.After(5 * time.Second)
+	for n := 0; ; n++ {
+		select {
+		case _, ok := <-input:
+			if !ok {
+				return
+			}
+			if n > 0 {
+				t.Fatal("input goes on after the terminal is closed")
+			}
+		case <-timeout:
+			t.Fatal("input is not closed in 5s after the terminal is closed")
+		}
+	}
+}
+
+func TestScreenGameOver(t *testing.T) {
+	term := newFakeTerminal(60, 12)
+	s := newTestScreen(term)
+
+	s.roundStarted(time.Now().Add(10 * time.Second))
+	s.gameOver(pb.EnumStatus_Winner)
+
+	got := term.last()
+	if l := got[len(got)-2]; l != "Game over. You won!" {
+		t.Errorf("message = %q, want %q", l, "Game over. You won!")
+	}
+	if strings.Contains(strings.Join(got, "\n"), "Choose your weapon") {
+		t.Errorf("screen prompts for a weapon after the game is over:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+// history returns the lines of the History section of the screen.
+func history(lines []string) []string {
+	var h []string
+	in := false
+	for _, l := range lines {
+		switch {
+		case l == "History":
+			in = true
+		case in && strings.HasPrefix(l, "─"):
+			return h
+		case in && l != "":
+			h = append(h, l)
+		}
+	}
+	return h
+}
+
+// footer returns the first line of the footer of the screen.
+func footer(lines []string) string {
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.HasPrefix(lines[i], "─") {
+			return lines[i+1]
+		}
+	}
+	return ""
+}
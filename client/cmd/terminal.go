@@ -0,0 +1,174 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Size of the terminal if it cannot be found out.
+const (
+	defaultColumns = 80
+	defaultRows    = 24
+)
+
+// pollInterval is how often the reader of the keys checks whether the terminal is closed.
+const pollInterval = 100 * time.Millisecond
+
+// ansiTerminal is the terminal of the process in raw mode controlled by ANSI escape sequences.
+type ansiTerminal struct {
+	in      *os.File
+	out     io.Writer
+	restore func() error
+	pressed chan rune
+	closed  chan struct{} // closed when the terminal is closed
+	stopped chan struct{} // closed when the reader of the keys has stopped
+}
+
+// openTerminal switches the terminal of the standard input to raw mode
+// and the standard output to the alternate screen.
+func openTerminal() (*ansiTerminal, error) {
+	restore, err := makeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		return nil, fmt.Errorf("cannot switch terminal to raw mode: %w", err)
+	}
+
+	t := &ansiTerminal{
+		in:      os.Stdin,
+		out:     os.Stdout,
+		restore: restore,
+		pressed: make(chan rune),
+		closed:  make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	fmt.Fprint(t.out, "\x1b[?1049h\x1b[?25l")
+	go t.read()
+
+	return t, nil
+}
+
+// close stops reading the keys and restores the terminal,
+// the input typed after that is left to the next reader.
+func (t *ansiTerminal) close() error {
+	close(t.closed)
+	<-t.stopped
+
+	fmt.Fprint(t.out, "\x1b[?25h\x1b[?1049l")
+	return t.restore()
+}
+
+// size implements terminal.
+func (t *ansiTerminal) size() (int, int) {
+	cols, rows, err := windowSize(int(t.in.Fd()))
+	if err != nil || cols <= 0 || rows <= 0 {
+		return defaultColumns, defaultRows
+	}
+	return cols, rows
+}
+
+// draw implements terminal.
+func (t *ansiTerminal) draw(lines []string) error {
+	var b strings.Builder
+	b.WriteString("\x1b[H")
+	for i, l := range lines {
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(l)
+		b.WriteString("\x1b[K")
+	}
+	b.WriteString("\x1b[J")
+
+	_, err := io.WriteString(t.out, b.String())
+	return err
+}
+
+// keys implements terminal.
+func (t *ansiTerminal) keys() <-chan rune {
+	return t.pressed
+}
+
+// done implements terminal.
+func (t *ansiTerminal) done() <-chan struct{} {
+	return t.closed
+}
+
+// read sends the pressed keys skipping the escape sequences of the special keys
+// until the terminal is closed. It waits for the input no longer than pollInterval at a time,
+// so that it does not read the input after the terminal is closed.
+func (t *ansiTerminal) read() {
+	defer close(t.stopped)
+	defer close(t.pressed)
+
+	r := bufio.NewReader(t.in)
+	for {
+		if r.Buffered() == 0 {
+			ready, err := waitInput(int(t.in.Fd()), pollInterval)
+			if err != nil {
+				return
+			}
+			select {
+			case <-t.closed:
+				return
+			default:
+			}
+			if !ready {
+				continue
+			}
+		}
+
+		k, _, err := r.ReadRune()
+		if err != nil {
+			return
+		}
+		if k == '\x1b' {
+			skipEscape(r)
+			continue
+		}
+		if k == keyCtrlC || unicode.IsLetter(k) || unicode.IsDigit(k) {
+			select {
+			case t.pressed <- unicode.ToLower(k):
+			case <-t.closed:
+				return
+			}
+		}
+	}
+}
+
+// skipEscape skips the rest of the escape sequence like "\x1b[A" of an arrow key.
+func skipEscape(r *bufio.Reader) {
+	if r.Buffered() == 0 {
+		return
+	}
+	k, _, err := r.ReadRune()
+	if err != nil || (k != '[' && k != 'O') {
+		return
+	}
+	for r.Buffered() > 0 {
+		k, _, err := r.ReadRune()
+		if err != nil || (k >= '@' && k <= '~') {
+			return
+		}
+	}
+}
@@ -0,0 +1,77 @@
+//go:build linux || darwin || dragonfly || freebsd || netbsd || openbsd
+// +build linux darwin dragonfly freebsd netbsd openbsd
+
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestTerminalClose(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	term := &ansiTerminal{
+		in:      r,
+		out:     ioutil.Discard,
+		restore: func() error { return nil },
+		pressed: make(chan rune),
+		closed:  make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go term.read()
+
+	if _, err := w.Write([]byte("R\x1b[Ap")); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []rune{'r', 'p'} {
+		select {
+		case k := <-term.keys():
+			if k != want {
+				t.Errorf("key = %q, want %q", k, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no key %q in 5s", want)
+		}
+	}
+
+	if err := term.close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-term.keys(); ok {
+		t.Error("keys are not closed with the terminal")
+	}
+
+	// the input typed after the terminal is closed is left to the next reader
+	if _, err := w.Write([]byte("s")); err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, 1)
+	if _, err := r.Read(b); err != nil || b[0] != 's' {
+		t.Errorf("input after close = %q, %v, want \"s\"", b, err)
+	}
+}
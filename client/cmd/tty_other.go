@@ -0,0 +1,46 @@
+//go:build !linux && !darwin && !dragonfly && !freebsd && !netbsd && !openbsd
+// +build !linux,!darwin,!dragonfly,!freebsd,!netbsd,!openbsd
+
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"runtime"
+	"time"
+)
+
+// errNoTTY means the full-screen mode is not supported on the platform.
+var errNoTTY = errors.New("full-screen mode is not supported on " + runtime.GOOS)
+
+// makeRaw switches the terminal to raw mode and returns the function to restore it.
+func makeRaw(fd int) (func() error, error) {
+	return nil, errNoTTY
+}
+
+// windowSize returns the number of the columns and the rows of the terminal.
+func windowSize(fd int) (int, int, error) {
+	return 0, 0, errNoTTY
+}
+
+// waitInput waits for the input of the terminal no longer than the timeout
+// and reports whether there is some.
+func waitInput(fd int, timeout time.Duration) (bool, error) {
+	return false, errNoTTY
+}
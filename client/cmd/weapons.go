@@ -0,0 +1,127 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// preferredKeys are the keys of the classic weapons players are used to.
+var preferredKeys = map[pb.EnumChoise]rune{
+	pb.EnumChoise_Stone:    'r',
+	pb.EnumChoise_Paper:    'p',
+	pb.EnumChoise_Scissors: 's',
+	pb.EnumChoise_Lizard:   'l',
+	pb.EnumChoise_Spock:    'v',
+}
+
+// arsenal is the weapons of the game with the keys to pick them.
+type arsenal struct {
+	weapons []*pb.Weapon
+	keys    map[pb.EnumChoise]rune
+}
+
+// newArsenal assigns a key to every weapon: the preferred one if it is free,
+// otherwise the first free letter of the weapon name. The quit key is never assigned.
+func newArsenal(weapons []*pb.Weapon) *arsenal {
+	a := &arsenal{
+		weapons: weapons,
+		keys:    make(map[pb.EnumChoise]rune, len(weapons)),
+	}
+
+	taken := map[rune]bool{keyQuit: true}
+	for _, w := range weapons {
+		if k, ok := preferredKeys[w.GetChoise()]; ok && !taken[k] {
+			a.keys[w.GetChoise()] = k
+			taken[k] = true
+		}
+	}
+	for _, w := range weapons {
+		if _, ok := a.keys[w.GetChoise()]; ok {
+			continue
+		}
+		for _, k := range strings.ToLower(w.GetName()) {
+			if unicode.IsLetter(k) && !taken[k] {
+				a.keys[w.GetChoise()] = k
+				taken[k] = true
+				break
+			}
+		}
+	}
+
+	return a
+}
+
+// key returns the key of the weapon, 0 if it has none.
+func (a *arsenal) key(c pb.EnumChoise) rune {
+	return a.keys[c]
+}
+
+// name returns the name of the weapon.
+func (a *arsenal) name(c pb.EnumChoise) string {
+	for _, w := range a.weapons {
+		if w.GetChoise() == c {
+			return w.GetName()
+		}
+	}
+	if c == pb.EnumChoise_UnknownChoise {
+		return "-"
+	}
+	return c.String()
+}
+
+// pick returns the weapon picked by the input: its key, its 1-based number or its name.
+// It reports false if the input picks no weapon.
+func (a *arsenal) pick(input string) (pb.EnumChoise, bool) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if input == "" {
+		return pb.EnumChoise_UnknownChoise, false
+	}
+
+	if n, err := strconv.Atoi(input); err == nil {
+		if n < 1 || n > len(a.weapons) {
+			return pb.EnumChoise_UnknownChoise, false
+		}
+		return a.weapons[n-1].GetChoise(), true
+	}
+
+	for _, w := range a.weapons {
+		if k := a.keys[w.GetChoise()]; input == string(k) || input == strings.ToLower(w.GetName()) {
+			return w.GetChoise(), true
+		}
+	}
+	return pb.EnumChoise_UnknownChoise, false
+}
+
+// help describes the keys of the weapons, e.g. "[r]Stone [s]Scissors [p]Paper".
+func (a *arsenal) help() string {
+	parts := make([]string, 0, len(a.weapons))
+	for i, w := range a.weapons {
+		k := a.keys[w.GetChoise()]
+		if k == 0 {
+			parts = append(parts, strconv.Itoa(i+1)+"."+w.GetName())
+			continue
+		}
+		parts = append(parts, "["+string(k)+"]"+w.GetName())
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,44 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+func TestArsenalQuitKey(t *testing.T) {
+	a := newArsenal([]*pb.Weapon{
+		{Choise: pb.EnumChoise_Stone, Name: "Stone"},
+		{Choise: pb.EnumChoise_Paper, Name: "Queen"},
+		{Choise: pb.EnumChoise_Scissors, Name: "Q"},
+	})
+
+	if k := a.key(pb.EnumChoise_Paper); k != 'p' {
+		t.Errorf("key of the preferred weapon = %q, want 'p'", k)
+	}
+	for _, w := range a.weapons {
+		if a.key(w.GetChoise()) == keyQuit {
+			t.Errorf("%s has the quit key", w.GetName())
+		}
+	}
+	if c, ok := a.pick("3"); !ok || c != pb.EnumChoise_Scissors {
+		t.Errorf("pick(3) = %v, %v, want the weapon without a key", c, ok)
+	}
+}
@@ -6,6 +6,8 @@ require (
 	github.com/golang/protobuf v1.4.2
 	github.com/spf13/cobra v1.0.0
 	github.com/spf13/viper v1.7.1
+	golang.org/x/crypto v0.0.0-20211117183948-ae814b36b871
+	golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1
 	google.golang.org/grpc v1.31.1
 	google.golang.org/protobuf v1.25.0
 )
@@ -0,0 +1,79 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package account keeps the accounts of the registered players.
+package account
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrNotFound means there is no such account.
+	ErrNotFound = errors.New("account is not found")
+
+	// ErrNameTaken means another account has the name.
+	ErrNameTaken = errors.New("account name is taken")
+)
+
+// Account is a registered player.
+type Account struct {
+	// ID is the unique stable player ID.
+	ID string
+
+	// Name is the unique player name.
+	Name string
+
+	// Created is the time the account was registered.
+	Created time.Time
+
+	// PasswordHash is the bcrypt hash of the player's password, if the player has one.
+	PasswordHash []byte `json:",omitempty"`
+
+	// PublicKey is the player's ed25519 public key, if the player has one.
+	PublicKey []byte `json:",omitempty"`
+
+	// Owner is the ID of the player who registered the account as a bot, if any.
+	Owner string `json:",omitempty"`
+}
+
+// Store keeps the accounts.
+// Implementations are safe for concurrent use.
+type Store interface {
+	// Create adds the account, it fails with ErrNameTaken if the name is taken.
+	Create(a Account) error
+
+	// Get returns the account by its ID, it fails with ErrNotFound if there is no such account.
+	Get(id string) (Account, error)
+
+	// Lookup returns the account by its name, it fails with ErrNotFound if there is no such account.
+	Lookup(name string) (Account, error)
+}
+
+// NewID returns a new random ID formatted as a version 4 UUID.
+func NewID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("cannot generate ID: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// HashPassword returns the bcrypt hash of the password to keep in an account.
+func HashPassword(password string) ([]byte, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("cannot hash password: %w", err)
+	}
+	return hash, nil
+}
+
+// CheckPublicKey checks that the key is an ed25519 public key.
+func CheckPublicKey(key []byte) error {
+	if len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("ed25519 public key must be %d bytes, got %d", ed25519.PublicKeySize, len(key))
+	}
+	return nil
+}
+
+// Protected reports whether the account has credentials.
+// Anyone who knows the name of an account without credentials can claim it.
+func (a Account) Protected() bool {
+	return len(a.PasswordHash) > 0 || len(a.PublicKey) > 0
+}
+
+// CheckPassword reports whether the password matches the password of the account.
+func (a Account) CheckPassword(password string) bool {
+	if len(a.PasswordHash) == 0 {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword(a.PasswordHash, []byte(password)) == nil
+}
+
+// CheckSignature reports whether the signature of the challenge is made by the key of the account.
+func (a Account) CheckSignature(challenge, signature []byte) bool {
+	if len(a.PublicKey) != ed25519.PublicKeySize {
+		return false
+	}
+	return ed25519.Verify(ed25519.PublicKey(a.PublicKey), challenge, signature)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+)
+
+func TestPassword(t *testing.T) {
+	hash, err := HashPassword("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := Account{PasswordHash: hash}
+
+	if !a.Protected() {
+		t.Error("account with a password is not protected")
+	}
+	if !a.CheckPassword("secret") {
+		t.Error("CheckPassword() of the password = false")
+	}
+	if a.CheckPassword("guess") {
+		t.Error("CheckPassword() of a wrong password = true")
+	}
+	if (Account{}).CheckPassword("") {
+		t.Error("CheckPassword() of an account without a password = true")
+	}
+}
+
+func TestSignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, other, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPublicKey(pub); err != nil {
+		t.Errorf("CheckPublicKey() = %v", err)
+	}
+	if err := CheckPublicKey(pub[1:]); err == nil {
+		t.Error("CheckPublicKey() of a short key succeeded")
+	}
+
+	a := Account{PublicKey: pub}
+	challenge := []byte("challenge")
+
+	if !a.Protected() {
+		t.Error("account with a public key is not protected")
+	}
+	if !a.CheckSignature(challenge, ed25519.Sign(priv, challenge)) {
+		t.Error("CheckSignature() of the signature = false")
+	}
+	if a.CheckSignature(challenge, ed25519.Sign(other, challenge)) {
+		t.Error("CheckSignature() of a signature of another key = true")
+	}
+	if a.CheckSignature([]byte("other"), ed25519.Sign(priv, challenge)) {
+		t.Error("CheckSignature() of a signature of another challenge = true")
+	}
+	if (Account{}).Protected() {
+		t.Error("account without credentials is protected")
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// File is a Store which keeps the accounts in memory and in a JSON file.
+type File struct {
+	path string
+	mem  *Memory
+}
+
+// OpenFile opens the store persisted in the file at the path.
+// The file is created on the first change if it does not exist.
+func OpenFile(path string) (*File, error) {
+	f := &File{
+		path: path,
+		mem:  NewMemory(),
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read accounts: %w", err)
+	}
+
+	var accounts []Account
+	if err := json.Unmarshal(b, &accounts); err != nil {
+		return nil, fmt.Errorf("cannot parse accounts %s: %w", path, err)
+	}
+	for _, a := range accounts {
+		if err := f.mem.create(a); err != nil {
+			return nil, fmt.Errorf("cannot load accounts %s: %w", path, err)
+		}
+	}
+
+	return f, nil
+}
+
+// Create implements Store.
+func (f *File) Create(a Account) error {
+	f.mem.mu.Lock()
+	defer f.mem.mu.Unlock()
+
+	if err := f.mem.create(a); err != nil {
+		return err
+	}
+	if err := f.save(); err != nil {
+		delete(f.mem.byID, a.ID)
+		delete(f.mem.byName, a.Name)
+		return err
+	}
+	return nil
+}
+
+// Get implements Store.
+func (f *File) Get(id string) (Account, error) {
+	return f.mem.Get(id)
+}
+
+// Lookup implements Store.
+func (f *File) Lookup(name string) (Account, error) {
+	return f.mem.Lookup(name)
+}
+
+// save writes the accounts to the file. mem.mu must be held.
+func (f *File) save() error {
+	accounts := f.mem.all()
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Created.Before(accounts[j].Created)
+	})
+
+	b, err := json.MarshalIndent(accounts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot marshal accounts: %w", err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return fmt.Errorf("cannot save accounts: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("cannot save accounts: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("cannot save accounts: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		return fmt.Errorf("cannot save accounts: %w", err)
+	}
+
+	return nil
+}
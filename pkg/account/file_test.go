@@ -0,0 +1,89 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFile(t *testing.T) {
+	f, err := OpenFile(filepath.Join(t.TempDir(), "accounts.json"))
+	if err != nil {
+		t.Fatalf("OpenFile() of a missing file = %v", err)
+	}
+	testStore(t, f)
+}
+
+func TestFileReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "accounts.json")
+	f, err := OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"alice", "bob"} {
+		id, err := NewID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := Account{ID: id, Name: name, Created: time.Now().Add(time.Duration(i) * time.Second), PublicKey: []byte{1, 2}}
+		if err := f.Create(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	alice, err := f.Lookup("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err = OpenFile(path)
+	if err != nil {
+		t.Fatalf("OpenFile() again = %v", err)
+	}
+	a, err := f.Lookup("alice")
+	if err != nil {
+		t.Fatalf("Lookup(alice) after reload = %v", err)
+	}
+	if a.ID != alice.ID || !a.Created.Equal(alice.Created) || len(a.PublicKey) != 2 {
+		t.Errorf("alice after reload = %+v, want %+v", a, alice)
+	}
+	if _, err := f.Get(alice.ID); err != nil {
+		t.Errorf("Get() of alice after reload = %v", err)
+	}
+	if err := f.Create(Account{ID: "x", Name: "bob"}); err == nil {
+		t.Error("Create() of a name taken before reload succeeded")
+	}
+}
+
+func TestOpenFileCorrupt(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"garbage":   "[{",
+		"duplicate": `[{"ID": "1", "Name": "alice"}, {"ID": "2", "Name": "alice"}]`,
+	} {
+		path := filepath.Join(dir, name+".json")
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := OpenFile(path); err == nil {
+			t.Errorf("OpenFile() of the %s file succeeded", name)
+		}
+	}
+}
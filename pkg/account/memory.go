@@ -0,0 +1,93 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Memory is a Store which keeps the accounts in memory.
+type Memory struct {
+	mu     sync.Mutex // protects all the fields below
+	byID   map[string]Account
+	byName map[string]string // IDs by name
+}
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{
+		byID:   make(map[string]Account),
+		byName: make(map[string]string),
+	}
+}
+
+// Create implements Store.
+func (m *Memory) Create(a Account) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.create(a)
+}
+
+// Get implements Store.
+func (m *Memory) Get(id string) (Account, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	a, ok := m.byID[id]
+	if !ok {
+		return Account{}, fmt.Errorf("account %q: %w", id, ErrNotFound)
+	}
+	return a, nil
+}
+
+// Lookup implements Store.
+func (m *Memory) Lookup(name string) (Account, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id, ok := m.byName[name]
+	if !ok {
+		return Account{}, fmt.Errorf("account of %q: %w", name, ErrNotFound)
+	}
+	return m.byID[id], nil
+}
+
+// create adds the account. mu must be held.
+func (m *Memory) create(a Account) error {
+	if _, ok := m.byName[a.Name]; ok {
+		return fmt.Errorf("%q: %w", a.Name, ErrNameTaken)
+	}
+	if _, ok := m.byID[a.ID]; ok {
+		return fmt.Errorf("account %q already exists", a.ID)
+	}
+
+	m.byID[a.ID] = a
+	m.byName[a.Name] = a.ID
+	return nil
+}
+
+// all returns all the accounts. mu must be held.
+func (m *Memory) all() []Account {
+	accounts := make([]Account, 0, len(m.byID))
+	for _, a := range m.byID {
+		accounts = append(accounts, a)
+	}
+	return accounts
+}
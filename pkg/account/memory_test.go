@@ -0,0 +1,74 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// testStore checks the store which has no accounts.
+func testStore(t *testing.T, s Store) {
+	t.Helper()
+
+	alice := Account{ID: "1", Name: "alice", Created: time.Now()}
+	if err := s.Create(alice); err != nil {
+		t.Fatalf("Create(alice) = %v", err)
+	}
+	if err := s.Create(Account{ID: "2", Name: "alice"}); !errors.Is(err, ErrNameTaken) {
+		t.Errorf("Create() of a taken name = %v, want %v", err, ErrNameTaken)
+	}
+	if err := s.Create(Account{ID: "1", Name: "bob"}); err == nil {
+		t.Error("Create() of a taken ID succeeded")
+	}
+
+	if a, err := s.Get("1"); err != nil || a.Name != "alice" {
+		t.Errorf("Get(1) = %+v, %v, want alice", a, err)
+	}
+	if a, err := s.Lookup("alice"); err != nil || a.ID != "1" {
+		t.Errorf("Lookup(alice) = %+v, %v, want ID 1", a, err)
+	}
+	if _, err := s.Get("2"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(2) = %v, want %v", err, ErrNotFound)
+	}
+	if _, err := s.Lookup("bob"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Lookup(bob) = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	testStore(t, NewMemory())
+}
+
+func TestNewID(t *testing.T) {
+	a, err := NewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b {
+		t.Errorf("NewID() = %q twice", a)
+	}
+	if len(a) != 36 || a[14] != '4' {
+		t.Errorf("NewID() = %q, want a version 4 UUID", a)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bot
+
+import (
+	"context"
+
+	"github.com/movaua/rock-paper-scissors/pkg/client"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// Play plays the game of the session with the choises picked by the strategy
+// until the game is over, the session fails or the context is done,
+// and returns the end of the game.
+// The session must have started playing the game with the weapons.
+// The choises which cannot be sent are skipped, the session reports the broken game.
+func Play(ctx context.Context, s *client.Session, st Strategy, weapons []*pb.Weapon) (client.GameOver, error) {
+	h := History{
+		PlayerID: s.Player().GetId(),
+		Weapons:  weapons,
+	}
+
+	var over client.GameOver
+	for {
+		select {
+		case e, ok := <-s.Events():
+			if !ok {
+				return over, s.Err()
+			}
+			switch e := e.(type) {
+			case client.RoundStarted:
+				_ = s.Choose(ctx, st.Choose(h))
+			case client.RoundEnded:
+				h.Rounds = append(h.Rounds, e.Results)
+			case client.GameOver:
+				over = e
+			}
+		case <-ctx.Done():
+			return over, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bot
+
+import (
+	"math/rand"
+	"time"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// Random returns the strategy which picks a uniformly random weapon every round.
+// A nil rng is replaced by a new one seeded by the current time.
+func Random(rng *rand.Rand) Strategy {
+	return &random{rng: orNewRand(rng)}
+}
+
+type random struct {
+	rng *rand.Rand
+}
+
+func (*random) Name() string { return "random" }
+
+// Choose implements Strategy.
+func (s *random) Choose(h History) pb.EnumChoise {
+	return pick(h.Weapons, s.rng)
+}
+
+// Constant returns the strategy which always picks the weapon,
+// or the first weapon of the game if the game has no such weapon.
+func Constant(c pb.EnumChoise) Strategy {
+	return &constant{choise: c}
+}
+
+type constant struct {
+	choise pb.EnumChoise
+	rng    *rand.Rand // picks the weapon in the first round if it is not set
+}
+
+func (*constant) Name() string { return "constant" }
+
+// Choose implements Strategy.
+func (s *constant) Choose(h History) pb.EnumChoise {
+	if s.choise == pb.EnumChoise_UnknownChoise && s.rng != nil {
+		s.choise = pick(h.Weapons, s.rng)
+	}
+	for _, w := range h.Weapons {
+		if w.GetChoise() == s.choise {
+			return s.choise
+		}
+	}
+	if len(h.Weapons) == 0 {
+		return pb.EnumChoise_UnknownChoise
+	}
+	return h.Weapons[0].GetChoise()
+}
+
+// Cycle returns the strategy which picks the weapons of the game one by one in their order.
+func Cycle() Strategy {
+	return cycle{}
+}
+
+type cycle struct{}
+
+func (cycle) Name() string { return "cycle" }
+
+// Choose implements Strategy.
+func (cycle) Choose(h History) pb.EnumChoise {
+	if len(h.Weapons) == 0 {
+		return pb.EnumChoise_UnknownChoise
+	}
+	return h.Weapons[len(h.Rounds)%len(h.Weapons)].GetChoise()
+}
+
+// Frequency returns the strategy which expects the opponents to repeat their most frequent choises
+// and picks the weapon which beats the most of them.
+// The ties are broken by the rng, a nil rng is replaced by a new one seeded by the current time.
+func Frequency(rng *rand.Rand) Strategy {
+	return &frequency{rng: orNewRand(rng)}
+}
+
+type frequency struct {
+	rng *rand.Rand
+}
+
+func (*frequency) Name() string { return "frequency" }
+
+// Choose implements Strategy.
+func (s *frequency) Choose(h History) pb.EnumChoise {
+	return counter(h.Weapons, frequencies(h), s.rng)
+}
+
+// frequencies returns the number of times the opponents have made every choise.
+func frequencies(h History) map[pb.EnumChoise]float64 {
+	freq := make(map[pb.EnumChoise]float64)
+	for _, round := range h.Rounds {
+		for _, r := range round {
+			if r.GetPlayer().GetId() != h.PlayerID && r.GetChoise() != pb.EnumChoise_UnknownChoise {
+				freq[r.GetChoise()]++
+			}
+		}
+	}
+	return freq
+}
+
+// Markov returns the strategy which learns how every opponent changes the choises from round to round,
+// predicts the next choises of the opponents by their last ones and picks the weapon
+// which beats the most of them. It falls back to Frequency while there is nothing learned.
+// The ties are broken by the rng, a nil rng is replaced by a new one seeded by the current time.
+func Markov(rng *rand.Rand) Strategy {
+	return &markov{rng: orNewRand(rng)}
+}
+
+type markov struct {
+	rng *rand.Rand
+}
+
+func (*markov) Name() string { return "markov" }
+
+// Choose implements Strategy.
+func (s *markov) Choose(h History) pb.EnumChoise {
+	// how many times every opponent has changed one choise to another
+	transitions := make(map[string]map[pb.EnumChoise]map[pb.EnumChoise]float64)
+	last := make(map[string]pb.EnumChoise) // the last choise of every opponent
+	for _, round := range h.Rounds {
+		for _, r := range round {
+			id, c := r.GetPlayer().GetId(), r.GetChoise()
+			if id == h.PlayerID {
+				continue
+			}
+			if prev, ok := last[id]; ok && prev != pb.EnumChoise_UnknownChoise && c != pb.EnumChoise_UnknownChoise {
+				if transitions[id] == nil {
+					transitions[id] = make(map[pb.EnumChoise]map[pb.EnumChoise]float64)
+				}
+				if transitions[id][prev] == nil {
+					transitions[id][prev] = make(map[pb.EnumChoise]float64)
+				}
+				transitions[id][prev][c]++
+			}
+			last[id] = c
+		}
+	}
+
+	predicted := make(map[pb.EnumChoise]float64)
+	for id, c := range last {
+		next := transitions[id][c]
+		total := 0.0
+		for _, n := range next {
+			total += n
+		}
+		for choise, n := range next {
+			predicted[choise] += n / total
+		}
+	}
+	if len(predicted) == 0 {
+		predicted = frequencies(h)
+	}
+
+	return counter(h.Weapons, predicted, s.rng)
+}
+
+// counter returns the weapon which beats the most of the expected opponent choises
+// weighted by their likelihood, less the ones which beat it.
+// The ties are broken randomly, a random weapon is picked if nothing is expected.
+func counter(weapons []*pb.Weapon, expected map[pb.EnumChoise]float64, rng *rand.Rand) pb.EnumChoise {
+	if len(expected) == 0 {
+		return pick(weapons, rng)
+	}
+
+	beats := make(map[pb.EnumChoise]map[pb.EnumChoise]bool, len(weapons))
+	for _, w := range weapons {
+		beats[w.GetChoise()] = make(map[pb.EnumChoise]bool, len(w.GetBeats()))
+		for _, b := range w.GetBeats() {
+			beats[w.GetChoise()][b] = true
+		}
+	}
+
+	var best []*pb.Weapon
+	bestScore := 0.0
+	for _, w := range weapons {
+		score := 0.0
+		for c, n := range expected {
+			switch {
+			case beats[w.GetChoise()][c]:
+				score += n
+			case beats[c][w.GetChoise()]:
+				score -= n
+			}
+		}
+		switch {
+		case len(best) == 0 || score > bestScore:
+			best, bestScore = []*pb.Weapon{w}, score
+		case score == bestScore:
+			best = append(best, w)
+		}
+	}
+
+	return pick(best, rng)
+}
+
+// pick returns a uniformly random weapon.
+func pick(weapons []*pb.Weapon, rng *rand.Rand) pb.EnumChoise {
+	if len(weapons) == 0 {
+		return pb.EnumChoise_UnknownChoise
+	}
+	return weapons[rng.Intn(len(weapons))].GetChoise()
+}
+
+// orNewRand returns the rng or, if it is nil, a new one seeded by the current time.
+func orNewRand(rng *rand.Rand) *rand.Rand {
+	if rng != nil {
+		return rng
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
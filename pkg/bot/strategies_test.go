@@ -0,0 +1,171 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bot
+
+import (
+	"math/rand"
+	"testing"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+func TestStrategiesNilRand(t *testing.T) {
+	weapons := []*pb.Weapon{
+		{Choise: pb.EnumChoise_Stone, Name: "Stone"},
+		{Choise: pb.EnumChoise_Scissors, Name: "Scissors"},
+		{Choise: pb.EnumChoise_Paper, Name: "Paper"},
+	}
+	h := History{PlayerID: "1", Weapons: weapons}
+
+	strategies := []Strategy{Random(nil), Frequency(nil), Markov(nil)}
+	for _, name := range StrategyNames() {
+		s, err := NewStrategy(name, nil)
+		if err != nil {
+			t.Fatalf("NewStrategy(%q) = %v", name, err)
+		}
+		strategies = append(strategies, s)
+	}
+
+	for _, s := range strategies {
+		c := s.Choose(h)
+		found := false
+		for _, w := range weapons {
+			found = found || w.GetChoise() == c
+		}
+		if !found {
+			t.Errorf("%s: Choose() = %v, want a weapon of the game", s.Name(), c)
+		}
+	}
+}
+
+func TestNewStrategyUnknown(t *testing.T) {
+	if _, err := NewStrategy("psychic", nil); err == nil {
+		t.Error("NewStrategy(psychic) = nil error")
+	}
+}
+
+// testWeapons returns the classic weapons.
+func testWeapons() []*pb.Weapon {
+	return []*pb.Weapon{
+		{Choise: pb.EnumChoise_Stone, Name: "Stone", Beats: []pb.EnumChoise{pb.EnumChoise_Scissors}},
+		{Choise: pb.EnumChoise_Scissors, Name: "Scissors", Beats: []pb.EnumChoise{pb.EnumChoise_Paper}},
+		{Choise: pb.EnumChoise_Paper, Name: "Paper", Beats: []pb.EnumChoise{pb.EnumChoise_Stone}},
+	}
+}
+
+// testHistory returns the history of the bot "1" whose opponents made the choises round by round.
+// The bot always picked Scissors.
+func testHistory(opponents map[string][]pb.EnumChoise) History {
+	h := History{PlayerID: "1", Weapons: testWeapons()}
+	for id, choises := range opponents {
+		for i, c := range choises {
+			if i == len(h.Rounds) {
+				h.Rounds = append(h.Rounds, []*pb.RoundResult{
+					{Player: &pb.Player{Id: "1"}, Choise: pb.EnumChoise_Scissors},
+				})
+			}
+			h.Rounds[i] = append(h.Rounds[i], &pb.RoundResult{Player: &pb.Player{Id: id}, Choise: c})
+		}
+	}
+	return h
+}
+
+func TestCycle(t *testing.T) {
+	want := []pb.EnumChoise{pb.EnumChoise_Stone, pb.EnumChoise_Scissors, pb.EnumChoise_Paper, pb.EnumChoise_Stone}
+	s := Cycle()
+	for i, w := range want {
+		h := testHistory(map[string][]pb.EnumChoise{"2": make([]pb.EnumChoise, i)})
+		if got := s.Choose(h); got != w {
+			t.Errorf("round %d: Choose() = %v, want %v", i+1, got, w)
+		}
+	}
+}
+
+func TestFrequency(t *testing.T) {
+	tests := []struct {
+		name      string
+		opponents map[string][]pb.EnumChoise
+		want      pb.EnumChoise
+	}{
+		{
+			name: "most frequent",
+			opponents: map[string][]pb.EnumChoise{
+				"2": {pb.EnumChoise_Stone, pb.EnumChoise_Paper, pb.EnumChoise_Stone, pb.EnumChoise_Stone},
+			},
+			want: pb.EnumChoise_Paper,
+		},
+		{
+			name: "all opponents",
+			opponents: map[string][]pb.EnumChoise{
+				"2": {pb.EnumChoise_Paper, pb.EnumChoise_Paper, pb.EnumChoise_Stone},
+				"3": {pb.EnumChoise_Paper, pb.EnumChoise_Paper, pb.EnumChoise_UnknownChoise},
+			},
+			want: pb.EnumChoise_Scissors,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Frequency(rand.New(rand.NewSource(1)))
+			if got := s.Choose(testHistory(tt.opponents)); got != tt.want {
+				t.Errorf("Choose() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkov(t *testing.T) {
+	tests := []struct {
+		name      string
+		opponents map[string][]pb.EnumChoise
+		want      pb.EnumChoise
+	}{
+		{
+			name: "nothing learned",
+			opponents: map[string][]pb.EnumChoise{
+				"2": {pb.EnumChoise_Stone},
+			},
+			want: pb.EnumChoise_Paper,
+		},
+		{
+			name: "transition",
+			opponents: map[string][]pb.EnumChoise{
+				// mostly Stone, but always Scissors after Stone
+				"2": {pb.EnumChoise_Stone, pb.EnumChoise_Scissors, pb.EnumChoise_Stone, pb.EnumChoise_Scissors, pb.EnumChoise_Stone},
+			},
+			want: pb.EnumChoise_Stone,
+		},
+		{
+			name: "every opponent",
+			opponents: map[string][]pb.EnumChoise{
+				"2": {pb.EnumChoise_Stone, pb.EnumChoise_Scissors, pb.EnumChoise_Stone, pb.EnumChoise_Scissors, pb.EnumChoise_Stone},
+				"3": {pb.EnumChoise_Scissors, pb.EnumChoise_Paper, pb.EnumChoise_Scissors, pb.EnumChoise_Paper, pb.EnumChoise_Scissors},
+			},
+			want: pb.EnumChoise_Scissors,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Markov(rand.New(rand.NewSource(1)))
+			if got := s.Choose(testHistory(tt.opponents)); got != tt.want {
+				t.Errorf("Choose() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package bot plays the game for bot players with the choises picked by strategies.
+package bot
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// History is the game a bot has played so far.
+type History struct {
+	// PlayerID is the ID of the bot player.
+	PlayerID string
+
+	// Weapons are the choises the bot can make in the game.
+	Weapons []*pb.Weapon
+
+	// Rounds are the results of the played rounds, the oldest first.
+	Rounds [][]*pb.RoundResult
+}
+
+// Strategy picks the choises of a bot.
+// A strategy may keep state between the rounds, so it is used by one bot at a time.
+type Strategy interface {
+	// Name returns the strategy name.
+	Name() string
+
+	// Choose returns the choise of the next round by the history of the game.
+	Choose(h History) pb.EnumChoise
+}
+
+// strategies are the constructors of the built-in strategies by their names.
+var strategies = map[string]func(rng *rand.Rand) Strategy{
+	"random":    func(rng *rand.Rand) Strategy { return Random(rng) },
+	"constant":  func(rng *rand.Rand) Strategy { return &constant{rng: rng} },
+	"cycle":     func(rng *rand.Rand) Strategy { return Cycle() },
+	"frequency": func(rng *rand.Rand) Strategy { return Frequency(rng) },
+	"markov":    func(rng *rand.Rand) Strategy { return Markov(rng) },
+}
+
+// NewStrategy returns a new built-in strategy by its name which picks random choises by the rng,
+// a nil rng is replaced by a new one seeded by the current time.
+// The constant strategy picks a random weapon in the first round and sticks to it.
+func NewStrategy(name string, rng *rand.Rand) (Strategy, error) {
+	newStrategy, ok := strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy %q, available are %v", name, StrategyNames())
+	}
+	return newStrategy(orNewRand(rng)), nil
+}
+
+// StrategyNames returns the sorted names of the built-in strategies.
+func StrategyNames() []string {
+	names := make([]string, 0, len(strategies))
+	for name := range strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,90 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package client
+
+import (
+	"time"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// Event is an event of the game the session plays:
+// RoundStarted, Revealing, RoundEnded, Reconnecting, Reconnected or GameOver.
+type Event interface {
+	event()
+}
+
+// RoundStarted means the players can make their choises of the next round.
+// The server does not tell when the game is over, so GameOver may follow instead of the round.
+type RoundStarted struct {
+	// Round is the 1-based number of the round.
+	Round int32
+
+	// Deadline is the time the choise has to be made by.
+	Deadline time.Time
+}
+
+// Revealing means all the players have committed to their choises in fair play
+// and the session has revealed the choise of the player.
+type Revealing struct {
+	// Commitments are the commitments of the players of the round.
+	Commitments []*pb.Commitment
+}
+
+// RoundEnded reports the results of a round.
+type RoundEnded struct {
+	// Round is the 1-based number of the round.
+	Round int32
+
+	// Results are the results of the players in the round.
+	Results []*pb.RoundResult
+
+	// Game are the current results of the players in the game.
+	Game []*pb.GameResult
+
+	// Mismatched are the players whose revealed choises do not match their commitments in fair play.
+	Mismatched []*pb.Player
+}
+
+// Reconnecting means the Play stream is broken and the session is about to reopen it.
+type Reconnecting struct {
+	// Attempt is the 1-based number of the attempt.
+	Attempt int
+
+	// Err is the reason of the reconnection.
+	Err error
+}
+
+// Reconnected means the Play stream is reopened.
+type Reconnected struct{}
+
+// GameOver means the game is over.
+type GameOver struct {
+	// Game are the final results of the players in the game.
+	Game []*pb.GameResult
+
+	// Result is the player's result of the game.
+	Result pb.EnumStatus
+}
+
+func (RoundStarted) event() {}
+func (Revealing) event()    {}
+func (RoundEnded) event()   {}
+func (Reconnecting) event() {}
+func (Reconnected) event()  {}
+func (GameOver) event()     {}
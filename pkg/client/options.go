@@ -0,0 +1,128 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package client
+
+import (
+	"crypto/ed25519"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Default reconnection of a broken Play stream.
+const (
+	DefaultBackoff     = time.Second
+	DefaultMaxBackoff  = 16 * time.Second
+	DefaultMaxAttempts = 5
+)
+
+// Option configures a session.
+type Option func(*options)
+
+type options struct {
+	creds       credentials.TransportCredentials
+	dialOptions []grpc.DialOption
+	password    string
+	key         ed25519.PrivateKey
+	ownerToken  string
+	reconnect   bool
+	roomID      string
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
+	eventBuffer int
+}
+
+func defaultOptions() options {
+	return options{
+		backoff:     DefaultBackoff,
+		maxBackoff:  DefaultMaxBackoff,
+		maxAttempts: DefaultMaxAttempts,
+		eventBuffer: 16,
+	}
+}
+
+// WithTransportCredentials connects to the server with the credentials, e.g. TLS,
+// the connection is plaintext by default.
+func WithTransportCredentials(creds credentials.TransportCredentials) Option {
+	return func(o *options) {
+		o.creds = creds
+	}
+}
+
+// WithDialOptions adds the options of the gRPC connection to the server.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// WithPassword registers the player with the password or reconnects with it.
+func WithPassword(password string) Option {
+	return func(o *options) {
+		o.password = password
+	}
+}
+
+// WithKey registers the player with the public key of the ed25519 key
+// or reconnects by signing the server challenge with it.
+func WithKey(key ed25519.PrivateKey) Option {
+	return func(o *options) {
+		o.key = key
+	}
+}
+
+// WithOwner registers the player as a bot owned by the player the session token is issued to.
+func WithOwner(token string) Option {
+	return func(o *options) {
+		o.ownerToken = token
+	}
+}
+
+// WithReconnect authenticates as the player registered with the name before
+// instead of registering a new player, the player is registered if the name is not taken yet.
+func WithReconnect() Option {
+	return func(o *options) {
+		o.reconnect = true
+	}
+}
+
+// WithRoom plays in the room with the ID instead of the default room.
+func WithRoom(roomID string) Option {
+	return func(o *options) {
+		o.roomID = roomID
+	}
+}
+
+// WithBackoff sets the delay before the first attempt to reopen a broken Play stream,
+// which doubles up to max with every attempt, and the number of attempts.
+func WithBackoff(initial, max time.Duration, attempts int) Option {
+	return func(o *options) {
+		o.backoff = initial
+		o.maxBackoff = max
+		o.maxAttempts = attempts
+	}
+}
+
+// WithEventBuffer sets the size of the buffer of the events channel.
+func WithEventBuffer(n int) Option {
+	return func(o *options) {
+		o.eventBuffer = n
+	}
+}
@@ -0,0 +1,486 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package client plays Rock Paper Scissors game on the game server for a player:
+// it authenticates the player, waits for the game to start, sends the player's choises
+// and reports the events of the game, reopening the Play stream if it breaks.
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/game"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrNotPlaying means the session has not started playing or the game is over.
+	ErrNotPlaying = errors.New("session is not playing")
+
+	// ErrPlaying means the session has already started playing.
+	ErrPlaying = errors.New("session has already started playing")
+)
+
+// Session is a session of a player on the game server.
+// Session is safe for concurrent use.
+type Session struct {
+	opts   options
+	name   string
+	conn   *grpc.ClientConn
+	client pb.GamerClient
+	ctx    context.Context // canceled when the session is closed
+	cancel context.CancelFunc
+	events chan Event
+	done   chan struct{} // closed when the game loop exits
+
+	mu      sync.Mutex // protects all the fields below
+	player  *pb.Player
+	token   string
+	started bool
+	ready   *pb.ReadyResponse
+	stream  pb.Gamer_PlayClient // nil when the session is not playing
+	choise  pb.EnumChoise       // committed choise in fair play
+	nonce   []byte              // nonce of the committed choise in fair play
+	err     error
+}
+
+// Dial connects to the game server at the address and authenticates the player with the name.
+// The name may be empty if the server takes it from the client certificate.
+func Dial(ctx context.Context, addr, name string, opts ...Option) (*Session, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	transport := grpc.WithInsecure()
+	if o.creds != nil {
+		transport = grpc.WithTransportCredentials(o.creds)
+	}
+	conn, err := grpc.DialContext(ctx, addr, append([]grpc.DialOption{transport}, o.dialOptions...)...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to %s: %w", addr, err)
+	}
+
+	sctx, cancel := context.WithCancel(context.Background())
+	s := &Session{
+		opts:   o,
+		name:   name,
+		conn:   conn,
+		client: pb.NewGamerClient(conn),
+		ctx:    sctx,
+		cancel: cancel,
+		events: make(chan Event, o.eventBuffer),
+		done:   make(chan struct{}),
+	}
+
+	if err := s.auth(ctx, o.reconnect); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// auth authenticates the player and keeps the session token.
+func (s *Session) auth(ctx context.Context, reconnect bool) error {
+	req := &pb.AuthRequest{
+		Name:       s.name,
+		Reconnect:  reconnect,
+		Password:   s.opts.password,
+		OwnerToken: s.opts.ownerToken,
+	}
+
+	if s.opts.key != nil {
+		switch {
+		case !reconnect:
+			req.PublicKey = s.opts.key.Public().(ed25519.PublicKey)
+		case s.opts.password == "":
+			ch, err := s.client.Challenge(ctx, &pb.ChallengeRequest{Name: s.name}, grpc.WaitForReady(true))
+			switch {
+			case err == nil:
+				req.Signature = ed25519.Sign(s.opts.key, ch.GetChallenge())
+				req.ChallengeId = ch.GetChallengeId()
+			case code(err) == codes.NotFound:
+				// the player is not registered yet, the server registers the player with the key
+				req.PublicKey = s.opts.key.Public().(ed25519.PublicKey)
+			case code(err) != codes.FailedPrecondition:
+				return fmt.Errorf("cannot get challenge: %w", err)
+			}
+		}
+	}
+
+	a, err := s.client.Auth(ctx, req, grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("cannot authenticate: %w", err)
+	}
+
+	s.mu.Lock()
+	s.player = a.GetPlayer()
+	s.token = a.GetToken()
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Player returns the authenticated player.
+func (s *Session) Player() *pb.Player {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.player
+}
+
+// Token returns the session token of the player.
+func (s *Session) Token() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token
+}
+
+// Client returns the client of the game server to make the other calls,
+// which are authenticated by the context returned by Context.
+func (s *Session) Client() pb.GamerClient {
+	return s.client
+}
+
+// Context returns the context which authenticates the calls by the session token.
+func (s *Session) Context(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+s.Token())
+}
+
+// Events returns the events of the game. The channel is closed when the game is over,
+// the Play stream cannot be reopened or the session is closed, see Err.
+func (s *Session) Events() <-chan Event {
+	return s.events
+}
+
+// Err returns the error the game has stopped with, nil if the game is over or is still played.
+func (s *Session) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.err
+}
+
+// Play joins the room, waits for the other players to get ready and starts playing.
+// It returns the settings of the game.
+func (s *Session) Play(ctx context.Context) (*pb.ReadyResponse, error) {
+	s.mu.Lock()
+	if s.started {
+		s.mu.Unlock()
+		return nil, ErrPlaying
+	}
+	s.started = true
+	s.mu.Unlock()
+
+	roomID := s.opts.roomID
+	if roomID != "" {
+		if _, err := s.client.JoinRoom(s.Context(ctx), &pb.JoinRoomRequest{RoomId: roomID}); err != nil {
+			return nil, fmt.Errorf("cannot join room %q: %w", roomID, err)
+		}
+	}
+
+	ready, err := s.client.Ready(s.Context(ctx), &pb.ReadyRequest{RoomId: roomID})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get ready: %w", err)
+	}
+
+	stream, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.ready = ready
+	s.stream = stream
+	s.mu.Unlock()
+
+	go s.run(stream, time.Duration(ready.GetChoiseTimeoutSeconds())*time.Second)
+
+	return ready, nil
+}
+
+// Choose makes the choise of the round, in fair play it commits to the choise
+// and the choise is revealed when all the players have committed.
+func (s *Session) Choose(ctx context.Context, c pb.EnumChoise) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stream == nil {
+		return ErrNotPlaying
+	}
+
+	msg := &pb.Choise{Choise: c}
+	if s.ready.GetFairPlay() {
+		nonce := make([]byte, game.MinNonceSize)
+		if _, err := rand.Read(nonce); err != nil {
+			return fmt.Errorf("cannot make nonce: %w", err)
+		}
+		s.choise, s.nonce = c, nonce
+		msg = &pb.Choise{Commitment: &pb.Commitment{Hash: game.Commit(c, nonce)}}
+	}
+
+	if err := s.stream.Send(msg); err != nil {
+		return fmt.Errorf("cannot send choise: %w", err)
+	}
+	return nil
+}
+
+// Close stops playing and closes the connection to the server.
+func (s *Session) Close() error {
+	s.cancel()
+	err := s.conn.Close()
+
+	s.mu.Lock()
+	playing := s.ready != nil
+	s.mu.Unlock()
+	if playing {
+		<-s.done
+	}
+
+	return err
+}
+
+// open opens the Play stream and joins the game.
+func (s *Session) open() (pb.Gamer_PlayClient, error) {
+	stream, err := s.client.Play(s.Context(s.ctx))
+	if err != nil {
+		return nil, fmt.Errorf("cannot start the game: %w", err)
+	}
+	if err := stream.Send(&pb.Choise{RoomId: s.opts.roomID}); err != nil {
+		return nil, fmt.Errorf("cannot join the game: %w", err)
+	}
+	return stream, nil
+}
+
+// reopen opens the Play stream again, authenticating the player first if reauth is set.
+func (s *Session) reopen(reauth bool) (pb.Gamer_PlayClient, error) {
+	if reauth {
+		if err := s.auth(s.ctx, true); err != nil {
+			return nil, err
+		}
+	}
+
+	stream, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.stream = stream
+	s.mu.Unlock()
+
+	return stream, nil
+}
+
+// run receives the scores of the game and reports the events until the game is over,
+// the stream cannot be reopened or the session is closed.
+func (s *Session) run(stream pb.Gamer_PlayClient, timeout time.Duration) {
+	defer close(s.done)
+	defer close(s.events)
+	defer s.stop(nil)
+
+	var (
+		commitments map[string][]byte
+		last        []*pb.GameResult
+		failures    int
+	)
+
+	s.emit(RoundStarted{Round: 1, Deadline: time.Now().Add(timeout)})
+
+	for {
+		score, err := stream.Recv()
+		if err == io.EOF {
+			s.emit(GameOver{Game: last, Result: result(last, s.Player().GetId())})
+			return
+		}
+		if err != nil {
+			for {
+				if s.ctx.Err() != nil {
+					return
+				}
+				failures++
+				if !retryable(err) || failures > s.opts.maxAttempts {
+					s.stop(err)
+					return
+				}
+				s.emit(Reconnecting{Attempt: failures, Err: err})
+				if !s.sleep(s.backoff(failures)) {
+					return
+				}
+				if stream, err = s.reopen(code(err) == codes.Unauthenticated); err == nil {
+					break
+				}
+			}
+			s.emit(Reconnected{})
+			continue
+		}
+		failures = 0
+
+		if len(score.GetRoundResults()) == 0 && len(score.GetCommitments()) > 0 {
+			commitments = make(map[string][]byte, len(score.GetCommitments()))
+			for _, c := range score.GetCommitments() {
+				commitments[c.GetPlayer().GetId()] = c.GetHash()
+			}
+			// a failed reveal breaks the stream, which is reported by Recv
+			_ = s.reveal(commitments)
+			s.emit(Revealing{Commitments: score.GetCommitments()})
+			continue
+		}
+
+		ended := RoundEnded{
+			Round:      rounds(score),
+			Results:    score.GetRoundResults(),
+			Game:       score.GetGameResults(),
+			Mismatched: mismatched(score.GetRoundResults(), commitments),
+		}
+		commitments = nil
+		last = score.GetGameResults()
+
+		s.emit(ended)
+		s.emit(RoundStarted{Round: ended.Round + 1, Deadline: time.Now().Add(timeout)})
+	}
+}
+
+// reveal reveals the committed choise if the player's commitment is among the commitments.
+func (s *Session) reveal(commitments map[string][]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := commitments[s.player.GetId()]; !ok || s.nonce == nil || s.stream == nil {
+		return nil
+	}
+
+	msg := &pb.Choise{Reveal: &pb.Reveal{Choise: s.choise, Nonce: s.nonce}}
+	s.nonce = nil
+	return s.stream.Send(msg)
+}
+
+// stop stops playing with the error.
+func (s *Session) stop(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stream = nil
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+}
+
+// emit reports the event unless the session is closed.
+func (s *Session) emit(e Event) {
+	select {
+	case s.events <- e:
+	case <-s.ctx.Done():
+	}
+}
+
+// sleep waits for the duration, it reports false if the session is closed meanwhile.
+func (s *Session) sleep(d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+// backoff returns the delay before the attempt to reopen the stream.
+func (s *Session) backoff(attempt int) time.Duration {
+	d := s.opts.backoff
+	for i := 1; i < attempt && d < s.opts.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > s.opts.maxBackoff {
+		d = s.opts.maxBackoff
+	}
+	return d
+}
+
+// retryable reports whether the Play stream broken by the error can be reopened:
+// the server is unavailable, the session token has expired
+// or the server has not noticed the broken stream yet.
+func retryable(err error) bool {
+	switch code(err) {
+	case codes.Unavailable, codes.Unauthenticated, codes.AlreadyExists:
+		return true
+	}
+	return false
+}
+
+// code returns the gRPC status code of the error, which may be wrapped.
+func code(err error) codes.Code {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus().Code()
+	}
+	return status.Code(err)
+}
+
+// rounds returns the number of the rounds played in the game by the score.
+func rounds(score *pb.Score) int32 {
+	n := int32(0)
+	for _, r := range score.GetGameResults() {
+		n = r.GetRounds()
+	}
+	return n
+}
+
+// result returns the game status of the player by the game results.
+func result(results []*pb.GameResult, playerID string) pb.EnumStatus {
+	for _, r := range results {
+		if r.GetPlayer().GetId() == playerID {
+			return r.GetStatus()
+		}
+	}
+	return pb.EnumStatus_UnknownStatus
+}
+
+// mismatched returns the players whose revealed choises do not match their commitments.
+func mismatched(results []*pb.RoundResult, commitments map[string][]byte) []*pb.Player {
+	var players []*pb.Player
+	for _, r := range results {
+		hash, ok := commitments[r.GetPlayer().GetId()]
+		if !ok || r.GetChoise() == pb.EnumChoise_UnknownChoise {
+			continue
+		}
+		if !game.CheckReveal(hash, r.GetChoise(), r.GetNonce()) {
+			players = append(players, r.GetPlayer())
+		}
+	}
+	return players
+}
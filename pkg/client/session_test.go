@@ -0,0 +1,391 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeGamer is a game server which plays the scripted Play streams one by one.
+type fakeGamer struct {
+	pb.UnimplementedGamerServer
+
+	mu      sync.Mutex
+	auths   []*pb.AuthRequest
+	tokens  map[string]bool // valid session tokens
+	scripts []func(pb.Gamer_PlayServer) error
+	plays   int
+}
+
+func (g *fakeGamer) Auth(ctx context.Context, req *pb.AuthRequest) (*pb.AuthResponse, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.auths = append(g.auths, req)
+	tok := "token-" + strconv.Itoa(len(g.auths))
+	g.tokens[tok] = true
+	return &pb.AuthResponse{Player: &pb.Player{Id: "1", Name: req.GetName()}, Token: tok}, nil
+}
+
+// Challenge fails as no player has registered with a key on the fake server.
+func (g *fakeGamer) Challenge(ctx context.Context, req *pb.ChallengeRequest) (*pb.ChallengeResponse, error) {
+	return nil, status.Errorf(codes.NotFound, "player %q is not found", req.GetName())
+}
+
+func (g *fakeGamer) Ready(ctx context.Context, req *pb.ReadyRequest) (*pb.ReadyResponse, error) {
+	if err := g.check(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.ReadyResponse{ChoiseTimeoutSeconds: 10}, nil
+}
+
+func (g *fakeGamer) Play(stream pb.Gamer_PlayServer) error {
+	if err := g.check(stream.Context()); err != nil {
+		return err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	if g.plays == len(g.scripts) {
+		g.mu.Unlock()
+		return status.Error(codes.FailedPrecondition, "game is over")
+	}
+	script := g.scripts[g.plays]
+	g.plays++
+	g.mu.Unlock()
+
+	return script(stream)
+}
+
+// check checks the session token of the call.
+func (g *fakeGamer) check(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, v := range md.Get("authorization") {
+		if g.tokens[v[len("Bearer "):]] {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid session token")
+}
+
+// revoke makes all the issued session tokens invalid, as a restarted server does.
+func (g *fakeGamer) revoke() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.tokens = make(map[string]bool)
+}
+
+// dial starts the game server playing the scripts and opens a session of alice on it.
+func dial(t *testing.T, g *fakeGamer, opts ...Option) *Session {
+	t.Helper()
+
+	g.tokens = make(map[string]bool)
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterGamerServer(srv, g)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	opts = append([]Option{WithDialOptions(grpc.WithContextDialer(dialer))}, opts...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s, err := Dial(ctx, "bufnet", "alice", opts...)
+	if err != nil {
+		t.Fatalf("Dial() = %v", err)
+	}
+	return s
+}
+
+// score is the score of the round in which alice beats bob with the weapon.
+func score(round int32, c pb.EnumChoise) *pb.Score {
+	alice := &pb.Player{Id: "1", Name: "alice"}
+	bob := &pb.Player{Id: "2", Name: "bob"}
+	return &pb.Score{
+		RoundResults: []*pb.RoundResult{
+			{Player: alice, Choise: c, Status: pb.EnumStatus_Winner},
+			{Player: bob, Status: pb.EnumStatus_Looser},
+		},
+		GameResults: []*pb.GameResult{
+			{Player: alice, Score: round, Status: pb.EnumStatus_Winner, Rounds: round},
+			{Player: bob, Status: pb.EnumStatus_Looser, Rounds: round},
+		},
+	}
+}
+
+// next returns the next event of the session.
+func next(t *testing.T, s *Session) Event {
+	t.Helper()
+
+	select {
+	case e, ok := <-s.Events():
+		if !ok {
+			t.Fatalf("events are closed, err = %v", s.Err())
+		}
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event in 5s")
+	}
+	return nil
+}
+
+// play plays the game in the session.
+func play(t *testing.T, s *Session) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ready, err := s.Play(ctx)
+	if err != nil {
+		t.Fatalf("Play() = %v", err)
+	}
+	if got := ready.GetChoiseTimeoutSeconds(); got != 10 {
+		t.Errorf("ChoiseTimeoutSeconds = %d, want 10", got)
+	}
+}
+
+// assertOver asserts that the game is over with alice winning.
+func assertOver(t *testing.T, s *Session) {
+	t.Helper()
+
+	e, ok := next(t, s).(GameOver)
+	if !ok {
+		t.Fatalf("event = %#v, want GameOver", e)
+	}
+	if e.Result != pb.EnumStatus_Winner {
+		t.Errorf("Result = %v, want Winner", e.Result)
+	}
+	if _, ok := <-s.Events(); ok {
+		t.Error("events are not closed after GameOver")
+	}
+	if err := s.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+}
+
+func TestSession(t *testing.T) {
+	g := &fakeGamer{
+		scripts: []func(pb.Gamer_PlayServer) error{
+			func(stream pb.Gamer_PlayServer) error {
+				c, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				return stream.Send(score(1, c.GetChoise()))
+			},
+		},
+	}
+	s := dial(t, g)
+	defer s.Close()
+
+	if got := s.Player().GetName(); got != "alice" {
+		t.Errorf("Player().Name = %q, want alice", got)
+	}
+	if got := s.Token(); got != "token-1" {
+		t.Errorf("Token() = %q, want token-1", got)
+	}
+	if err := s.Choose(context.Background(), pb.EnumChoise_Stone); !errors.Is(err, ErrNotPlaying) {
+		t.Errorf("Choose() before Play = %v, want %v", err, ErrNotPlaying)
+	}
+
+	play(t, s)
+	if _, err := s.Play(context.Background()); !errors.Is(err, ErrPlaying) {
+		t.Errorf("second Play() = %v, want %v", err, ErrPlaying)
+	}
+
+	if e, ok := next(t, s).(RoundStarted); !ok || e.Round != 1 {
+		t.Fatalf("event = %#v, want RoundStarted of round 1", e)
+	}
+	if err := s.Choose(context.Background(), pb.EnumChoise_Stone); err != nil {
+		t.Fatalf("Choose() = %v", err)
+	}
+
+	ended, ok := next(t, s).(RoundEnded)
+	if !ok {
+		t.Fatalf("event = %#v, want RoundEnded", ended)
+	}
+	if ended.Round != 1 {
+		t.Errorf("Round = %d, want 1", ended.Round)
+	}
+	if got := ended.Results[0].GetChoise(); got != pb.EnumChoise_Stone {
+		t.Errorf("choise = %v, want Stone", got)
+	}
+	if e, ok := next(t, s).(RoundStarted); !ok || e.Round != 2 {
+		t.Fatalf("event = %#v, want RoundStarted of round 2", e)
+	}
+
+	assertOver(t, s)
+}
+
+func TestSessionReconnect(t *testing.T) {
+	g := &fakeGamer{}
+	g.scripts = []func(pb.Gamer_PlayServer) error{
+		func(pb.Gamer_PlayServer) error {
+			return status.Error(codes.Unavailable, "server is restarting")
+		},
+		func(pb.Gamer_PlayServer) error {
+			g.revoke()
+			return status.Error(codes.Unauthenticated, "session token has expired")
+		},
+		func(stream pb.Gamer_PlayServer) error {
+			return stream.Send(score(1, pb.EnumChoise_Paper))
+		},
+	}
+	s := dial(t, g, WithBackoff(time.Millisecond, time.Millisecond, 3))
+	defer s.Close()
+
+	play(t, s)
+	if _, ok := next(t, s).(RoundStarted); !ok {
+		t.Fatal("first event is not RoundStarted")
+	}
+
+	for _, want := range []codes.Code{codes.Unavailable, codes.Unauthenticated} {
+		e, ok := next(t, s).(Reconnecting)
+		if !ok {
+			t.Fatalf("event = %#v, want Reconnecting", e)
+		}
+		if got := status.Code(e.Err); got != want {
+			t.Errorf("Reconnecting.Err = %v, want %v", e.Err, want)
+		}
+		if e, ok := next(t, s).(Reconnected); !ok {
+			t.Fatalf("event = %#v, want Reconnected", e)
+		}
+	}
+
+	if e, ok := next(t, s).(RoundEnded); !ok || e.Round != 1 {
+		t.Fatalf("event = %#v, want RoundEnded of round 1", e)
+	}
+	next(t, s) // RoundStarted of round 2
+	assertOver(t, s)
+
+	if len(g.auths) != 2 {
+		t.Fatalf("authenticated %d times, want 2", len(g.auths))
+	}
+	if !g.auths[1].GetReconnect() {
+		t.Error("re-authentication does not reconnect")
+	}
+	if got := s.Token(); got != "token-2" {
+		t.Errorf("Token() = %q, want token-2", got)
+	}
+}
+
+func TestSessionReconnectFails(t *testing.T) {
+	g := &fakeGamer{}
+	for i := 0; i < 3; i++ {
+		g.scripts = append(g.scripts, func(pb.Gamer_PlayServer) error {
+			return status.Error(codes.Unavailable, "server is restarting")
+		})
+	}
+	s := dial(t, g, WithBackoff(time.Millisecond, time.Millisecond, 2))
+	defer s.Close()
+
+	play(t, s)
+	for e := range s.Events() {
+		if _, ok := e.(GameOver); ok {
+			t.Fatal("game is over, want it interrupted")
+		}
+	}
+	if got := status.Code(s.Err()); got != codes.Unavailable {
+		t.Errorf("Err() = %v, want %v", s.Err(), codes.Unavailable)
+	}
+}
+
+func TestSessionCloseUnread(t *testing.T) {
+	g := &fakeGamer{
+		scripts: []func(pb.Gamer_PlayServer) error{
+			func(stream pb.Gamer_PlayServer) error {
+				for i := int32(1); i <= 100; i++ {
+					if err := stream.Send(score(i, pb.EnumChoise_Stone)); err != nil {
+						return err
+					}
+				}
+				<-stream.Context().Done()
+				return nil
+			},
+		},
+	}
+	s := dial(t, g, WithEventBuffer(1))
+
+	play(t, s)
+	next(t, s)
+
+	closed := make(chan error, 1)
+	go func() {
+		closed <- s.Close()
+	}()
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Errorf("Close() = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close() blocks on the unread events")
+	}
+
+	for range s.Events() {
+	}
+	if err := s.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+	if err := s.Choose(context.Background(), pb.EnumChoise_Stone); !errors.Is(err, ErrNotPlaying) {
+		t.Errorf("Choose() after Close = %v, want %v", err, ErrNotPlaying)
+	}
+}
+
+func TestDialRegistersBot(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &fakeGamer{}
+	dial(t, g, WithReconnect(), WithKey(key), WithOwner("owner-token"))
+
+	req := g.auths[0]
+	if !bytes.Equal(req.GetPublicKey(), key.Public().(ed25519.PublicKey)) || len(req.GetSignature()) > 0 {
+		t.Errorf("Auth() request = %v, want the public key of a new player", req)
+	}
+	if req.GetOwnerToken() != "owner-token" {
+		t.Errorf("owner token = %q, want owner-token", req.GetOwnerToken())
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package game
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"strconv"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// MinNonceSize is the minimum size of the nonce of a commitment,
+// shorter nonces would let the opponents guess the committed choise.
+const MinNonceSize = 16
+
+// Commit returns the commitment hash of the choise with the nonce:
+// SHA-256 of the decimal number of the choise, a colon and the nonce.
+func Commit(choise pb.EnumChoise, nonce []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(strconv.Itoa(int(choise)) + ":"))
+	h.Write(nonce)
+	return h.Sum(nil)
+}
+
+// CheckReveal reports whether the choise with the nonce matches the commitment hash
+// and the nonce is at least MinNonceSize bytes.
+func CheckReveal(hash []byte, choise pb.EnumChoise, nonce []byte) bool {
+	if len(nonce) < MinNonceSize {
+		return false
+	}
+	return subtle.ConstantTimeCompare(hash, Commit(choise, nonce)) == 1
+}
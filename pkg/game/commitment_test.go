@@ -0,0 +1,57 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package game
+
+import (
+	"bytes"
+	"testing"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+func TestCheckReveal(t *testing.T) {
+	nonce := bytes.Repeat([]byte{7}, MinNonceSize)
+	hash := Commit(pb.EnumChoise_Stone, nonce)
+
+	tampered := append([]byte(nil), hash...)
+	tampered[0] ^= 1
+	short := nonce[1:]
+
+	tests := []struct {
+		name   string
+		hash   []byte
+		choise pb.EnumChoise
+		nonce  []byte
+		want   bool
+	}{
+		{name: "match", hash: hash, choise: pb.EnumChoise_Stone, nonce: nonce, want: true},
+		{name: "other choise", hash: hash, choise: pb.EnumChoise_Paper, nonce: nonce},
+		{name: "other nonce", hash: hash, choise: pb.EnumChoise_Stone, nonce: bytes.Repeat([]byte{8}, MinNonceSize)},
+		{name: "tampered hash", hash: tampered, choise: pb.EnumChoise_Stone, nonce: nonce},
+		{name: "short nonce", hash: Commit(pb.EnumChoise_Stone, short), choise: pb.EnumChoise_Stone, nonce: short},
+		{name: "no hash", choise: pb.EnumChoise_Stone, nonce: nonce},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckReveal(tt.hash, tt.choise, tt.nonce); got != tt.want {
+				t.Errorf("CheckReveal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package game
+
+import "sort"
+
+// Ending decides when a game is over.
+type Ending interface {
+	// Over reports whether the game is over
+	// after the number of completed rounds with the current scores.
+	Over(rounds int, scores map[string]int32) bool
+}
+
+// Unlimited never ends the game.
+var Unlimited Ending = unlimited{}
+
+type unlimited struct{}
+
+func (unlimited) Over(int, map[string]int32) bool { return false }
+
+// FixedRounds ends the game after n rounds.
+func FixedRounds(n int) Ending {
+	return fixedRounds(n)
+}
+
+type fixedRounds int
+
+func (n fixedRounds) Over(rounds int, _ map[string]int32) bool {
+	return rounds >= int(n)
+}
+
+// BestOf ends the game after n rounds or earlier,
+// as soon as the leader cannot be caught up in the remaining rounds
+// where a player gets at most the points the policy gives in a round.
+func BestOf(n int, policy Policy) Ending {
+	return bestOf{rounds: n, policy: policy}
+}
+
+type bestOf struct {
+	rounds int
+	policy Policy
+}
+
+func (e bestOf) Over(rounds int, scores map[string]int32) bool {
+	if rounds >= e.rounds {
+		return true
+	}
+	top := topScores(scores)
+	if len(top) < 2 {
+		return false
+	}
+	left := int(e.policy.MaxPoints(len(scores))) * (e.rounds - rounds)
+	return int(top[0]-top[1]) > left
+}
+
+// FirstTo ends the game when a player gets k points.
+func FirstTo(k int) Ending {
+	return firstTo(k)
+}
+
+type firstTo int
+
+func (k firstTo) Over(_ int, scores map[string]int32) bool {
+	top := topScores(scores)
+	return len(top) > 0 && top[0] >= int32(k)
+}
+
+// SuddenDeath prolongs the game ending by e while the leaders are tied,
+// so the game is over after the first round which breaks the tie.
+func SuddenDeath(e Ending) Ending {
+	return suddenDeath{e}
+}
+
+type suddenDeath struct {
+	Ending
+}
+
+func (e suddenDeath) Over(rounds int, scores map[string]int32) bool {
+	if !e.Ending.Over(rounds, scores) {
+		return false
+	}
+	top := topScores(scores)
+	return len(top) < 2 || top[0] != top[1]
+}
+
+// topScores returns up to two best scores in the descending order.
+func topScores(scores map[string]int32) []int32 {
+	top := make([]int32, 0, len(scores))
+	for _, sc := range scores {
+		top = append(top, sc)
+	}
+	sort.Slice(top, func(i, j int) bool { return top[i] > top[j] })
+	if len(top) > 2 {
+		top = top[:2]
+	}
+	return top
+}
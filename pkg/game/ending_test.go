@@ -0,0 +1,55 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package game
+
+import "testing"
+
+func TestEndingOver(t *testing.T) {
+	tests := []struct {
+		name   string
+		ending Ending
+		rounds int
+		scores map[string]int32
+		want   bool
+	}{
+		{"unlimited", Unlimited, 100, map[string]int32{"a": 100, "b": 0}, false},
+		{"fixed rounds before", FixedRounds(3), 2, map[string]int32{"a": 2, "b": 0}, false},
+		{"fixed rounds after", FixedRounds(3), 3, map[string]int32{"a": 1, "b": 1}, true},
+		{"best of all rounds", BestOf(3, Draw), 3, map[string]int32{"a": 1, "b": 1}, true},
+		{"best of catchable", BestOf(5, Draw), 2, map[string]int32{"a": 2, "b": 0}, false},
+		{"best of decided", BestOf(5, Draw), 3, map[string]int32{"a": 3, "b": 0}, true},
+		{"best of single player", BestOf(5, Draw), 3, map[string]int32{"a": 3}, false},
+		{"best of pairwise catchable", BestOf(5, Pairwise), 3, map[string]int32{"a": 3, "b": 0, "c": 0}, false},
+		{"best of pairwise decided", BestOf(5, Pairwise), 4, map[string]int32{"a": 6, "b": 3, "c": 0}, true},
+		{"first to below", FirstTo(3), 10, map[string]int32{"a": 2, "b": 2}, false},
+		{"first to reached", FirstTo(3), 3, map[string]int32{"a": 3, "b": 0}, true},
+		{"first to no scores", FirstTo(1), 0, map[string]int32{}, false},
+		{"sudden death not over", SuddenDeath(FixedRounds(3)), 2, map[string]int32{"a": 1, "b": 0}, false},
+		{"sudden death tied", SuddenDeath(FixedRounds(3)), 4, map[string]int32{"a": 2, "b": 2}, false},
+		{"sudden death broken", SuddenDeath(FixedRounds(3)), 5, map[string]int32{"a": 3, "b": 2}, true},
+		{"sudden death first to tied", SuddenDeath(FirstTo(2)), 4, map[string]int32{"a": 2, "b": 2}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ending.Over(tt.rounds, tt.scores); got != tt.want {
+				t.Errorf("Over(%d, %v) = %v, want %v", tt.rounds, tt.scores, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package game implements Rock Paper Scissors game rules
+// independently of the way the players are connected to the game.
+package game
+
+import pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+// Match tracks the rounds and the cumulative scores of a game.
+// Match is not safe for concurrent use.
+type Match struct {
+	ruleset    Ruleset
+	policy     Policy
+	ending     Ending
+	rounds     int
+	scores     map[string]int32
+	eliminated map[string]bool
+	forfeited  map[string]bool
+}
+
+// NewMatch creates a new match played by the ruleset with rounds resolved by the policy,
+// the match is over when the ending says so.
+func NewMatch(ruleset Ruleset, policy Policy, ending Ending) *Match {
+	return &Match{
+		ruleset:    ruleset,
+		policy:     policy,
+		ending:     ending,
+		scores:     make(map[string]int32),
+		eliminated: make(map[string]bool),
+		forfeited:  make(map[string]bool),
+	}
+}
+
+// Play resolves a round by the players' choises, updates the scores
+// and returns the round status of every player.
+// Eliminated players and the players who have forfeited the game do not play and are loosers of the round.
+// When only one player remains after an elimination,
+// the player gets a point and the eliminated players return to the game.
+func (m *Match) Play(choises map[string]pb.EnumChoise) map[string]pb.EnumStatus {
+	active := make(map[string]pb.EnumChoise, len(choises))
+	statuses := make(map[string]pb.EnumStatus, len(choises))
+	for id, c := range choises {
+		if m.eliminated[id] || m.forfeited[id] {
+			statuses[id] = pb.EnumStatus_Looser
+			continue
+		}
+		active[id] = c
+	}
+
+	for id, o := range m.policy.Resolve(m.ruleset, active) {
+		statuses[id] = o.Status
+		m.scores[id] += o.Points
+		if o.Eliminated {
+			m.eliminated[id] = true
+		}
+	}
+	for id := range statuses {
+		if _, ok := m.scores[id]; !ok {
+			m.scores[id] = 0
+		}
+	}
+
+	if len(m.eliminated) > 0 {
+		var remaining []string
+		for id := range active {
+			if !m.eliminated[id] {
+				remaining = append(remaining, id)
+			}
+		}
+		if len(remaining) <= 1 {
+			for _, id := range remaining {
+				m.scores[id]++
+			}
+			m.eliminated = make(map[string]bool)
+		}
+	}
+
+	m.rounds++
+
+	return statuses
+}
+
+// Rounds returns the number of completed rounds.
+func (m *Match) Rounds() int {
+	return m.rounds
+}
+
+// Over reports whether the match is over.
+func (m *Match) Over() bool {
+	return m.ending.Over(m.rounds, m.scores)
+}
+
+// Score returns the current score of the player.
+func (m *Match) Score(playerID string) int32 {
+	return m.scores[playerID]
+}
+
+// Join adds the player to the game with no points unless the player is already in it,
+// so that the player has a game status before playing a round.
+func (m *Match) Join(playerID string) {
+	if _, ok := m.scores[playerID]; !ok {
+		m.scores[playerID] = 0
+	}
+}
+
+// Forfeit makes the player a looser of the game whatever the player's score is.
+func (m *Match) Forfeit(playerID string) {
+	m.forfeited[playerID] = true
+	m.Join(playerID)
+}
+
+// Forfeited reports whether the player has forfeited the game.
+func (m *Match) Forfeited(playerID string) bool {
+	return m.forfeited[playerID]
+}
+
+// Statuses returns the current game status of every player who has played a round or forfeited.
+// The only leader of the players who have not forfeited is the winner,
+// several leaders are in a draw, the rest are loosers.
+func (m *Match) Statuses() map[string]pb.EnumStatus {
+	var best int32
+	leaders := 0
+	for id, sc := range m.scores {
+		if m.forfeited[id] {
+			continue
+		}
+		switch {
+		case leaders == 0 || sc > best:
+			best, leaders = sc, 1
+		case sc == best:
+			leaders++
+		}
+	}
+
+	statuses := make(map[string]pb.EnumStatus, len(m.scores))
+	for id, sc := range m.scores {
+		switch {
+		case m.forfeited[id] || sc < best:
+			statuses[id] = pb.EnumStatus_Looser
+		case leaders == 1:
+			statuses[id] = pb.EnumStatus_Winner
+		default:
+			statuses[id] = pb.EnumStatus_Draw
+		}
+	}
+	return statuses
+}
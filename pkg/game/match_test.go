@@ -0,0 +1,145 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package game
+
+import (
+	"testing"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+const (
+	stone    = pb.EnumChoise_Stone
+	scissors = pb.EnumChoise_Scissors
+	paper    = pb.EnumChoise_Paper
+	unknown  = pb.EnumChoise_UnknownChoise
+
+	winner = pb.EnumStatus_Winner
+	looser = pb.EnumStatus_Looser
+	draw   = pb.EnumStatus_Draw
+)
+
+func TestMatch(t *testing.T) {
+	m := NewMatch(Classic, Draw, FixedRounds(3))
+
+	rounds := []struct {
+		choises map[string]pb.EnumChoise
+		want    map[string]pb.EnumStatus
+	}{
+		{
+			choises: map[string]pb.EnumChoise{"a": stone, "b": scissors},
+			want:    map[string]pb.EnumStatus{"a": winner, "b": looser},
+		},
+		{
+			choises: map[string]pb.EnumChoise{"a": paper, "b": paper},
+			want:    map[string]pb.EnumStatus{"a": draw, "b": draw},
+		},
+		{
+			choises: map[string]pb.EnumChoise{"a": stone, "b": paper},
+			want:    map[string]pb.EnumStatus{"a": looser, "b": winner},
+		},
+	}
+
+	for i, r := range rounds {
+		if m.Over() {
+			t.Fatalf("match is over before round %d", i+1)
+		}
+		assertStatuses(t, m.Play(r.choises), r.want)
+	}
+
+	if !m.Over() {
+		t.Fatal("match is not over after 3 rounds")
+	}
+	if m.Rounds() != 3 {
+		t.Errorf("Rounds() = %d, want 3", m.Rounds())
+	}
+	if m.Score("a") != 1 || m.Score("b") != 1 {
+		t.Errorf("scores are %d:%d, want 1:1", m.Score("a"), m.Score("b"))
+	}
+	assertStatuses(t, m.Statuses(), map[string]pb.EnumStatus{"a": draw, "b": draw})
+}
+
+func TestMatchStatuses(t *testing.T) {
+	m := NewMatch(Classic, Draw, Unlimited)
+	m.Play(map[string]pb.EnumChoise{"a": stone, "b": scissors, "c": unknown})
+	assertStatuses(t, m.Statuses(), map[string]pb.EnumStatus{"a": winner, "b": looser, "c": looser})
+}
+
+func TestMatchElimination(t *testing.T) {
+	m := NewMatch(Classic, Elimination, Unlimited)
+
+	assertStatuses(t,
+		m.Play(map[string]pb.EnumChoise{"a": stone, "b": stone, "c": scissors}),
+		map[string]pb.EnumStatus{"a": winner, "b": winner, "c": looser})
+	if m.Score("a") != 0 || m.Score("b") != 0 {
+		t.Fatalf("scores are %d:%d before the elimination is over, want 0:0", m.Score("a"), m.Score("b"))
+	}
+
+	assertStatuses(t,
+		m.Play(map[string]pb.EnumChoise{"a": paper, "b": stone, "c": paper}),
+		map[string]pb.EnumStatus{"a": winner, "b": looser, "c": looser})
+	if m.Score("a") != 1 || m.Score("b") != 0 || m.Score("c") != 0 {
+		t.Fatalf("scores are %d:%d:%d, want 1:0:0", m.Score("a"), m.Score("b"), m.Score("c"))
+	}
+
+	// everyone returns to the game after the elimination is over
+	assertStatuses(t,
+		m.Play(map[string]pb.EnumChoise{"a": paper, "b": scissors, "c": paper}),
+		map[string]pb.EnumStatus{"a": looser, "b": winner, "c": looser})
+}
+
+func TestMatchForfeit(t *testing.T) {
+	m := NewMatch(Classic, Draw, Unlimited)
+	m.Play(map[string]pb.EnumChoise{"a": stone, "b": scissors, "c": scissors})
+	m.Play(map[string]pb.EnumChoise{"a": stone, "b": stone, "c": scissors})
+
+	m.Forfeit("a")
+	m.Forfeit("d")
+	if !m.Forfeited("a") || m.Forfeited("b") {
+		t.Fatalf("forfeited a, b = %v, %v, want true, false", m.Forfeited("a"), m.Forfeited("b"))
+	}
+	assertStatuses(t, m.Statuses(), map[string]pb.EnumStatus{"a": looser, "b": winner, "c": looser, "d": looser})
+
+	// the players who have forfeited do not play
+	assertStatuses(t,
+		m.Play(map[string]pb.EnumChoise{"a": paper, "b": scissors, "c": stone}),
+		map[string]pb.EnumStatus{"a": looser, "b": looser, "c": winner})
+	if m.Score("a") != 2 {
+		t.Errorf("score of a = %d after the forfeit, want 2", m.Score("a"))
+	}
+	assertStatuses(t, m.Statuses(), map[string]pb.EnumStatus{"a": looser, "b": draw, "c": draw, "d": looser})
+}
+
+func TestMatchForfeitBeforeRound(t *testing.T) {
+	m := NewMatch(Classic, Draw, Unlimited)
+	m.Join("a")
+	m.Forfeit("b")
+	assertStatuses(t, m.Statuses(), map[string]pb.EnumStatus{"a": winner, "b": looser})
+}
+
+func assertStatuses(t *testing.T, got, want map[string]pb.EnumStatus) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("statuses = %v, want %v", got, want)
+	}
+	for id, st := range want {
+		if got[id] != st {
+			t.Fatalf("statuses = %v, want %v", got, want)
+		}
+	}
+}
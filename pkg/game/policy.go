@@ -0,0 +1,201 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package game
+
+import (
+	"fmt"
+	"sort"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// Outcome is the result of a round for a player.
+type Outcome struct {
+	// Status is the round status of the player.
+	Status pb.EnumStatus
+
+	// Points are added to the game score of the player.
+	Points int32
+
+	// Eliminated means the player drops out of the game
+	// until only one player remains.
+	Eliminated bool
+}
+
+// Policy resolves a round played by any number of players.
+type Policy interface {
+	// Name returns the policy name.
+	Name() string
+
+	// Resolve returns the outcome of every player by the player's choise.
+	// Players who did not make a choise have pb.EnumChoise_UnknownChoise.
+	Resolve(r Ruleset, choises map[string]pb.EnumChoise) map[string]Outcome
+
+	// MaxPoints returns the most points a player can get in a round of n players.
+	MaxPoints(n int) int32
+}
+
+// Built-in policies.
+var (
+	// Draw makes the round a draw unless exactly two different weapons were chosen,
+	// or a single weapon was chosen while some players did not choose:
+	// then the players with the stronger weapon win a point and the rest lose.
+	Draw Policy = drawPolicy{}
+
+	// Pairwise plays every pair of players against each other:
+	// a player gets a point per beaten opponent and wins the round
+	// if the player has beaten more opponents than has been beaten by.
+	Pairwise Policy = pairwisePolicy{}
+
+	// Elimination resolves the round like Draw, but without points:
+	// the loosers drop out until one player remains, who wins a point.
+	Elimination Policy = eliminationPolicy{}
+)
+
+var policies = map[string]Policy{
+	Draw.Name():        Draw,
+	Pairwise.Name():    Pairwise,
+	Elimination.Name(): Elimination,
+}
+
+// LookupPolicy returns the built-in policy by its name.
+func LookupPolicy(name string) (Policy, error) {
+	p, ok := policies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown policy %q, available are %v", name, PolicyNames())
+	}
+	return p, nil
+}
+
+// PolicyNames returns the sorted names of the built-in policies.
+func PolicyNames() []string {
+	names := make([]string, 0, len(policies))
+	for name := range policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type drawPolicy struct{}
+
+func (drawPolicy) Name() string { return "draw" }
+
+func (drawPolicy) MaxPoints(int) int32 { return 1 }
+
+// Resolve implements Policy.
+// Players who did not choose in time lose to everyone who did.
+func (drawPolicy) Resolve(r Ruleset, choises map[string]pb.EnumChoise) map[string]Outcome {
+	made := make(map[pb.EnumChoise]bool)
+	absent := false
+	for _, c := range choises {
+		if c == pb.EnumChoise_UnknownChoise {
+			absent = true
+			continue
+		}
+		made[c] = true
+	}
+
+	var winner pb.EnumChoise
+	switch len(made) {
+	case 1:
+		if absent {
+			for c := range made {
+				winner = c
+			}
+		}
+	case 2:
+		var cs []pb.EnumChoise
+		for c := range made {
+			cs = append(cs, c)
+		}
+		if r.Beats(cs[0], cs[1]) {
+			winner = cs[0]
+		} else {
+			winner = cs[1]
+		}
+	}
+
+	outcomes := make(map[string]Outcome, len(choises))
+	for id, c := range choises {
+		switch {
+		case c == pb.EnumChoise_UnknownChoise && len(made) > 0:
+			outcomes[id] = Outcome{Status: pb.EnumStatus_Looser}
+		case winner == pb.EnumChoise_UnknownChoise:
+			outcomes[id] = Outcome{Status: pb.EnumStatus_Draw}
+		case c == winner:
+			outcomes[id] = Outcome{Status: pb.EnumStatus_Winner, Points: 1}
+		default:
+			outcomes[id] = Outcome{Status: pb.EnumStatus_Looser}
+		}
+	}
+	return outcomes
+}
+
+type pairwisePolicy struct{}
+
+func (pairwisePolicy) Name() string { return "pairwise" }
+
+func (pairwisePolicy) MaxPoints(n int) int32 { return int32(n - 1) }
+
+// Resolve implements Policy.
+// A player who did not choose in time is beaten by everyone who did.
+func (pairwisePolicy) Resolve(r Ruleset, choises map[string]pb.EnumChoise) map[string]Outcome {
+	outcomes := make(map[string]Outcome, len(choises))
+	for a, ca := range choises {
+		var won, lost int32
+		for b, cb := range choises {
+			if a == b {
+				continue
+			}
+			switch {
+			case ca != pb.EnumChoise_UnknownChoise && (cb == pb.EnumChoise_UnknownChoise || r.Beats(ca, cb)):
+				won++
+			case cb != pb.EnumChoise_UnknownChoise && (ca == pb.EnumChoise_UnknownChoise || r.Beats(cb, ca)):
+				lost++
+			}
+		}
+
+		o := Outcome{Points: won, Status: pb.EnumStatus_Draw}
+		switch {
+		case won > lost:
+			o.Status = pb.EnumStatus_Winner
+		case won < lost:
+			o.Status = pb.EnumStatus_Looser
+		}
+		outcomes[a] = o
+	}
+	return outcomes
+}
+
+type eliminationPolicy struct{}
+
+func (eliminationPolicy) Name() string { return "elimination" }
+
+func (eliminationPolicy) MaxPoints(int) int32 { return 1 }
+
+// Resolve implements Policy.
+func (eliminationPolicy) Resolve(r Ruleset, choises map[string]pb.EnumChoise) map[string]Outcome {
+	outcomes := Draw.Resolve(r, choises)
+	for id, o := range outcomes {
+		o.Points = 0
+		o.Eliminated = o.Status == pb.EnumStatus_Looser
+		outcomes[id] = o
+	}
+	return outcomes
+}
@@ -0,0 +1,173 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package game
+
+import (
+	"testing"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+func TestPolicyResolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  Policy
+		choises map[string]pb.EnumChoise
+		want    map[string]Outcome
+	}{
+		{
+			name:    "draw two weapons",
+			policy:  Draw,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": scissors, "c": stone},
+			want: map[string]Outcome{
+				"a": {Status: winner, Points: 1},
+				"b": {Status: looser},
+				"c": {Status: winner, Points: 1},
+			},
+		},
+		{
+			name:    "draw same weapon",
+			policy:  Draw,
+			choises: map[string]pb.EnumChoise{"a": paper, "b": paper},
+			want:    map[string]Outcome{"a": {Status: draw}, "b": {Status: draw}},
+		},
+		{
+			name:    "draw three weapons",
+			policy:  Draw,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": scissors, "c": paper},
+			want:    map[string]Outcome{"a": {Status: draw}, "b": {Status: draw}, "c": {Status: draw}},
+		},
+		{
+			name:    "draw three weapons and a timeout",
+			policy:  Draw,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": scissors, "c": paper, "d": unknown},
+			want:    map[string]Outcome{"a": {Status: draw}, "b": {Status: draw}, "c": {Status: draw}, "d": {Status: looser}},
+		},
+		{
+			name:    "draw single weapon and a timeout",
+			policy:  Draw,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": unknown},
+			want:    map[string]Outcome{"a": {Status: winner, Points: 1}, "b": {Status: looser}},
+		},
+		{
+			name:    "draw two weapons and a timeout",
+			policy:  Draw,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": paper, "c": unknown},
+			want:    map[string]Outcome{"a": {Status: looser}, "b": {Status: winner, Points: 1}, "c": {Status: looser}},
+		},
+		{
+			name:    "draw nobody chose",
+			policy:  Draw,
+			choises: map[string]pb.EnumChoise{"a": unknown, "b": unknown},
+			want:    map[string]Outcome{"a": {Status: draw}, "b": {Status: draw}},
+		},
+		{
+			name:    "pairwise",
+			policy:  Pairwise,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": scissors, "c": scissors},
+			want: map[string]Outcome{
+				"a": {Status: winner, Points: 2},
+				"b": {Status: looser},
+				"c": {Status: looser},
+			},
+		},
+		{
+			name:    "pairwise balanced",
+			policy:  Pairwise,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": scissors, "c": paper},
+			want: map[string]Outcome{
+				"a": {Status: draw, Points: 1},
+				"b": {Status: draw, Points: 1},
+				"c": {Status: draw, Points: 1},
+			},
+		},
+		{
+			name:    "pairwise timeout",
+			policy:  Pairwise,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": paper, "c": unknown},
+			want: map[string]Outcome{
+				"a": {Status: draw, Points: 1},
+				"b": {Status: winner, Points: 2},
+				"c": {Status: looser},
+			},
+		},
+		{
+			name:    "elimination",
+			policy:  Elimination,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": scissors, "c": unknown},
+			want: map[string]Outcome{
+				"a": {Status: winner},
+				"b": {Status: looser, Eliminated: true},
+				"c": {Status: looser, Eliminated: true},
+			},
+		},
+		{
+			name:    "elimination draw",
+			policy:  Elimination,
+			choises: map[string]pb.EnumChoise{"a": stone, "b": stone},
+			want:    map[string]Outcome{"a": {Status: draw}, "b": {Status: draw}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.Resolve(Classic, tt.choises)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Resolve() = %v, want %v", got, tt.want)
+			}
+			for id, o := range tt.want {
+				if got[id] != o {
+					t.Errorf("outcome of %s = %+v, want %+v", id, got[id], o)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyMaxPoints(t *testing.T) {
+	tests := []struct {
+		policy Policy
+		n      int
+		want   int32
+	}{
+		{Draw, 5, 1},
+		{Elimination, 5, 1},
+		{Pairwise, 2, 1},
+		{Pairwise, 5, 4},
+	}
+	for _, tt := range tests {
+		if got := tt.policy.MaxPoints(tt.n); got != tt.want {
+			t.Errorf("%s.MaxPoints(%d) = %d, want %d", tt.policy.Name(), tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestLookupPolicy(t *testing.T) {
+	for _, name := range PolicyNames() {
+		p, err := LookupPolicy(name)
+		if err != nil {
+			t.Fatalf("LookupPolicy(%s) = %v", name, err)
+		}
+		if p.Name() != name {
+			t.Errorf("LookupPolicy(%s) returned %s", name, p.Name())
+		}
+	}
+	if _, err := LookupPolicy("nope"); err == nil {
+		t.Error("LookupPolicy(nope) = nil error")
+	}
+}
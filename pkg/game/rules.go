@@ -0,0 +1,264 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package game
+
+import (
+	"fmt"
+	"sort"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// Ruleset defines the weapons and which weapon beats which.
+type Ruleset interface {
+	// Name returns the ruleset name.
+	Name() string
+
+	// Weapons returns the choises a player can make.
+	Weapons() []pb.EnumChoise
+
+	// WeaponName returns the name of the weapon.
+	WeaponName(c pb.EnumChoise) string
+
+	// Beats reports whether weapon a beats weapon b.
+	Beats(a, b pb.EnumChoise) bool
+}
+
+// Built-in rulesets.
+var (
+	// Classic is the Stone Scissors Paper ruleset.
+	Classic = NewBalanced("classic",
+		pb.EnumChoise_Stone, pb.EnumChoise_Scissors, pb.EnumChoise_Paper)
+
+	// RPSLS is the Rock Paper Scissors Lizard Spock ruleset.
+	RPSLS = NewBalanced("rpsls",
+		pb.EnumChoise_Stone, pb.EnumChoise_Scissors, pb.EnumChoise_Lizard, pb.EnumChoise_Paper, pb.EnumChoise_Spock)
+
+	// RPS7 is the 7 weapons ruleset.
+	RPS7 = NewBalanced("rps7",
+		pb.EnumChoise_Stone, pb.EnumChoise_Fire, pb.EnumChoise_Scissors, pb.EnumChoise_Sponge,
+		pb.EnumChoise_Paper, pb.EnumChoise_Air, pb.EnumChoise_Water)
+
+	// RPS9 is the 9 weapons ruleset.
+	RPS9 = NewBalanced("rps9",
+		pb.EnumChoise_Stone, pb.EnumChoise_Fire, pb.EnumChoise_Scissors, pb.EnumChoise_Human, pb.EnumChoise_Sponge,
+		pb.EnumChoise_Paper, pb.EnumChoise_Air, pb.EnumChoise_Water, pb.EnumChoise_Gun)
+
+	// RPS15 is the 15 weapons ruleset.
+	RPS15 = NewBalanced("rps15",
+		pb.EnumChoise_Stone, pb.EnumChoise_Fire, pb.EnumChoise_Scissors, pb.EnumChoise_Snake, pb.EnumChoise_Human,
+		pb.EnumChoise_Tree, pb.EnumChoise_Wolf, pb.EnumChoise_Sponge, pb.EnumChoise_Paper, pb.EnumChoise_Air,
+		pb.EnumChoise_Water, pb.EnumChoise_Dragon, pb.EnumChoise_Devil, pb.EnumChoise_Lightning, pb.EnumChoise_Gun)
+)
+
+// Rulesets is a set of rulesets by name.
+type Rulesets map[string]Ruleset
+
+// Builtin returns a new set of the built-in rulesets.
+func Builtin() Rulesets {
+	rs := make(Rulesets)
+	for _, r := range []Ruleset{Classic, RPSLS, RPS7, RPS9, RPS15} {
+		rs[r.Name()] = r
+	}
+	return rs
+}
+
+// Add adds the ruleset to the set. Ruleset names are unique.
+func (rs Rulesets) Add(r Ruleset) error {
+	if _, ok := rs[r.Name()]; ok {
+		return fmt.Errorf("ruleset %q already exists", r.Name())
+	}
+	rs[r.Name()] = r
+	return nil
+}
+
+// Lookup returns the ruleset by its name.
+func (rs Rulesets) Lookup(name string) (Ruleset, error) {
+	r, ok := rs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown ruleset %q, available are %v", name, rs.Names())
+	}
+	return r, nil
+}
+
+// Names returns the sorted ruleset names.
+func (rs Rulesets) Names() []string {
+	names := make([]string, 0, len(rs))
+	for name := range rs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsWeapon reports whether the choise is a weapon of the ruleset.
+func IsWeapon(r Ruleset, c pb.EnumChoise) bool {
+	for _, w := range r.Weapons() {
+		if w == c {
+			return true
+		}
+	}
+	return false
+}
+
+// graph is a ruleset defined by the set of "a beats b" pairs.
+type graph struct {
+	name    string
+	weapons []pb.EnumChoise
+	names   map[pb.EnumChoise]string
+	beats   map[[2]pb.EnumChoise]bool
+}
+
+// NewBalanced creates a ruleset of an odd number of weapons
+// where each weapon beats the next half of the weapons in the circular order.
+// It panics if the number of weapons is even or less than 3.
+func NewBalanced(name string, weapons ...pb.EnumChoise) Ruleset {
+	n := len(weapons)
+	if n < 3 || n%2 == 0 {
+		panic(fmt.Sprintf("game: balanced ruleset %q needs an odd number of weapons, got %d", name, n))
+	}
+
+	g := &graph{
+		name:    name,
+		weapons: weapons,
+		names:   make(map[pb.EnumChoise]string, n),
+		beats:   make(map[[2]pb.EnumChoise]bool),
+	}
+	for i, a := range weapons {
+		g.names[a] = a.String()
+		for j := 1; j <= n/2; j++ {
+			g.beats[[2]pb.EnumChoise{a, weapons[(i+j)%n]}] = true
+		}
+	}
+	return g
+}
+
+// WeaponDef defines a weapon of a custom ruleset.
+type WeaponDef struct {
+	// Name is the weapon name.
+	// The weapon of a name of pb.EnumChoise is picked by that choise,
+	// other weapons are picked by the choises following the pb.EnumChoise values.
+	Name string
+
+	// Beats are the names of the weapons this weapon beats.
+	Beats []string
+}
+
+// NewGraph creates a custom ruleset from the weapon definitions.
+// Every pair of different weapons must be decided: exactly one of them beats the other.
+func NewGraph(name string, defs []WeaponDef) (Ruleset, error) {
+	if name == "" {
+		return nil, fmt.Errorf("ruleset name is empty")
+	}
+	if len(defs) < 2 {
+		return nil, fmt.Errorf("ruleset %q: needs at least 2 weapons, got %d", name, len(defs))
+	}
+
+	g := &graph{
+		name:  name,
+		names: make(map[pb.EnumChoise]string, len(defs)),
+		beats: make(map[[2]pb.EnumChoise]bool),
+	}
+
+	ids := make(map[string]pb.EnumChoise, len(defs))
+	next := pb.EnumChoise(len(pb.EnumChoise_name))
+	for _, d := range defs {
+		if d.Name == "" {
+			return nil, fmt.Errorf("ruleset %q: weapon name is empty", name)
+		}
+		if _, ok := ids[d.Name]; ok {
+			return nil, fmt.Errorf("ruleset %q: weapon %q is defined twice", name, d.Name)
+		}
+		id, ok := pb.EnumChoise_value[d.Name]
+		c := pb.EnumChoise(id)
+		if !ok || c == pb.EnumChoise_UnknownChoise {
+			c = next
+			next++
+		}
+		ids[d.Name] = c
+		g.names[c] = d.Name
+		g.weapons = append(g.weapons, c)
+	}
+
+	for _, d := range defs {
+		for _, b := range d.Beats {
+			if b == d.Name {
+				return nil, fmt.Errorf("ruleset %q: weapon %q beats itself", name, d.Name)
+			}
+			c, ok := ids[b]
+			if !ok {
+				return nil, fmt.Errorf("ruleset %q: weapon %q beats unknown weapon %q", name, d.Name, b)
+			}
+			g.beats[[2]pb.EnumChoise{ids[d.Name], c}] = true
+		}
+	}
+
+	for i, a := range g.weapons {
+		for _, b := range g.weapons[i+1:] {
+			ab, ba := g.Beats(a, b), g.Beats(b, a)
+			if ab && ba {
+				return nil, fmt.Errorf("ruleset %q: weapons %q and %q beat each other", name, g.names[a], g.names[b])
+			}
+			if !ab && !ba {
+				return nil, fmt.Errorf("ruleset %q: neither of weapons %q and %q beats the other", name, g.names[a], g.names[b])
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// CheckBalanced checks that each weapon of the ruleset beats exactly half of the other weapons.
+func CheckBalanced(r Ruleset) error {
+	n := len(r.Weapons())
+	if n%2 == 0 {
+		return fmt.Errorf("ruleset %q: balanced ruleset needs an odd number of weapons, got %d", r.Name(), n)
+	}
+	for _, a := range r.Weapons() {
+		beaten := 0
+		for _, b := range r.Weapons() {
+			if r.Beats(a, b) {
+				beaten++
+			}
+		}
+		if beaten != n/2 {
+			return fmt.Errorf("ruleset %q: weapon %q beats %d weapons, balanced ruleset needs %d", r.Name(), r.WeaponName(a), beaten, n/2)
+		}
+	}
+	return nil
+}
+
+// Name implements Ruleset.
+func (g *graph) Name() string {
+	return g.name
+}
+
+// Weapons implements Ruleset.
+func (g *graph) Weapons() []pb.EnumChoise {
+	return g.weapons
+}
+
+// WeaponName implements Ruleset.
+func (g *graph) WeaponName(c pb.EnumChoise) string {
+	return g.names[c]
+}
+
+// Beats implements Ruleset.
+func (g *graph) Beats(a, b pb.EnumChoise) bool {
+	return g.beats[[2]pb.EnumChoise{a, b}]
+}
@@ -0,0 +1,189 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package game
+
+import (
+	"testing"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+func TestNewBalanced(t *testing.T) {
+	for _, r := range []Ruleset{Classic, RPSLS, RPS7, RPS9, RPS15} {
+		if err := CheckBalanced(r); err != nil {
+			t.Errorf("CheckBalanced(%s) = %v", r.Name(), err)
+		}
+	}
+
+	if !Classic.Beats(pb.EnumChoise_Stone, pb.EnumChoise_Scissors) ||
+		!Classic.Beats(pb.EnumChoise_Scissors, pb.EnumChoise_Paper) ||
+		!Classic.Beats(pb.EnumChoise_Paper, pb.EnumChoise_Stone) {
+		t.Error("classic ruleset does not follow Stone > Scissors > Paper > Stone")
+	}
+	if Classic.Beats(pb.EnumChoise_Stone, pb.EnumChoise_Stone) {
+		t.Error("a weapon beats itself")
+	}
+
+	for _, n := range []int{0, 1, 2, 4} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewBalanced with %d weapons did not panic", n)
+				}
+			}()
+			NewBalanced("bad", RPS15.Weapons()[:n]...)
+		}()
+	}
+}
+
+func TestNewGraph(t *testing.T) {
+	tests := []struct {
+		name    string
+		ruleset string
+		defs    []WeaponDef
+		wantErr bool
+	}{
+		{
+			name:    "classic",
+			ruleset: "mine",
+			defs: []WeaponDef{
+				{Name: "Stone", Beats: []string{"Scissors"}},
+				{Name: "Scissors", Beats: []string{"Paper"}},
+				{Name: "Paper", Beats: []string{"Stone"}},
+			},
+		},
+		{
+			name:    "custom names",
+			ruleset: "mine",
+			defs: []WeaponDef{
+				{Name: "Bear", Beats: []string{"Ninja"}},
+				{Name: "Ninja", Beats: []string{"Cowboy"}},
+				{Name: "Cowboy", Beats: []string{"Bear"}},
+			},
+		},
+		{
+			name:    "two weapons",
+			ruleset: "mine",
+			defs: []WeaponDef{
+				{Name: "Stone", Beats: []string{"Scissors"}},
+				{Name: "Scissors"},
+			},
+		},
+		{name: "empty name", ruleset: "", defs: []WeaponDef{{Name: "a", Beats: []string{"b"}}, {Name: "b"}}, wantErr: true},
+		{name: "one weapon", ruleset: "mine", defs: []WeaponDef{{Name: "a"}}, wantErr: true},
+		{name: "empty weapon name", ruleset: "mine", defs: []WeaponDef{{Name: "", Beats: []string{"b"}}, {Name: "b"}}, wantErr: true},
+		{name: "duplicate weapon", ruleset: "mine", defs: []WeaponDef{{Name: "a", Beats: []string{"b"}}, {Name: "a"}}, wantErr: true},
+		{name: "beats itself", ruleset: "mine", defs: []WeaponDef{{Name: "a", Beats: []string{"a", "b"}}, {Name: "b"}}, wantErr: true},
+		{name: "unknown weapon", ruleset: "mine", defs: []WeaponDef{{Name: "a", Beats: []string{"c"}}, {Name: "b"}}, wantErr: true},
+		{name: "beat each other", ruleset: "mine", defs: []WeaponDef{{Name: "a", Beats: []string{"b"}}, {Name: "b", Beats: []string{"a"}}}, wantErr: true},
+		{name: "undecided pair", ruleset: "mine", defs: []WeaponDef{{Name: "a"}, {Name: "b"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewGraph(tt.ruleset, tt.defs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewGraph() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(r.Weapons()) != len(tt.defs) {
+				t.Fatalf("got %d weapons, want %d", len(r.Weapons()), len(tt.defs))
+			}
+			for i, d := range tt.defs {
+				w := r.Weapons()[i]
+				if r.WeaponName(w) != d.Name {
+					t.Errorf("weapon %d is named %q, want %q", i, r.WeaponName(w), d.Name)
+				}
+				for _, b := range d.Beats {
+					if !r.Beats(w, weaponByName(t, r, b)) {
+						t.Errorf("%s does not beat %s", d.Name, b)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewGraphKnownChoises(t *testing.T) {
+	r, err := NewGraph("mine", []WeaponDef{
+		{Name: "Stone", Beats: []string{"Bear"}},
+		{Name: "Bear"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Weapons()[0]; got != pb.EnumChoise_Stone {
+		t.Errorf("Stone is picked by %v, want %v", got, pb.EnumChoise_Stone)
+	}
+	if got := r.Weapons()[1]; int(got) < len(pb.EnumChoise_name) {
+		t.Errorf("Bear is picked by the known choise %v", got)
+	}
+}
+
+func TestCheckBalanced(t *testing.T) {
+	r, err := NewGraph("mine", []WeaponDef{
+		{Name: "a", Beats: []string{"b", "c"}},
+		{Name: "b", Beats: []string{"c"}},
+		{Name: "c"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckBalanced(r); err == nil {
+		t.Error("CheckBalanced() of an unbalanced ruleset = nil")
+	}
+}
+
+func TestRulesets(t *testing.T) {
+	rs := Builtin()
+	if _, err := rs.Lookup("rpsls"); err != nil {
+		t.Errorf("Lookup(rpsls) = %v", err)
+	}
+	if _, err := rs.Lookup("nope"); err == nil {
+		t.Error("Lookup(nope) = nil error")
+	}
+	if err := rs.Add(NewBalanced("classic", Classic.Weapons()...)); err == nil {
+		t.Error("Add() of a duplicate name = nil error")
+	}
+	if err := rs.Add(NewBalanced("mine", Classic.Weapons()...)); err != nil {
+		t.Errorf("Add(mine) = %v", err)
+	}
+	want := []string{"classic", "mine", "rps15", "rps7", "rps9", "rpsls"}
+	got := rs.Names()
+	if len(got) != len(want) {
+		t.Fatalf("Names() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Names() = %v, want %v", got, want)
+		}
+	}
+}
+
+func weaponByName(t *testing.T, r Ruleset, name string) pb.EnumChoise {
+	t.Helper()
+	for _, w := range r.Weapons() {
+		if r.WeaponName(w) == name {
+			return w
+		}
+	}
+	t.Fatalf("ruleset %s has no weapon %q", r.Name(), name)
+	return pb.EnumChoise_UnknownChoise
+}
@@ -0,0 +1,176 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package matchmaking groups the players waiting for a game into matches.
+package matchmaking
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Ticket is a player waiting for a match.
+type Ticket struct {
+	// PlayerID is the ID of the waiting player.
+	PlayerID string
+
+	// Size is the number of players in the match.
+	Size int
+
+	// Ruleset is the name of the game ruleset.
+	Ruleset string
+
+	// Rating is the player's skill rating.
+	Rating float64
+
+	// Since is the time the player started to wait.
+	Since time.Time
+}
+
+// compatible reports whether the players of the tickets can play in the same match.
+func (t *Ticket) compatible(o *Ticket) bool {
+	return t.Size == o.Size && t.Ruleset == o.Ruleset
+}
+
+// Window is the acceptable difference of the ratings of the matched players,
+// which grows with the waiting time.
+type Window struct {
+	// Initial is the rating difference accepted right away.
+	Initial float64
+
+	// Growth is the rating difference added per second of waiting.
+	Growth float64
+
+	// Max is the maximum rating difference, 0 means the ratings are not taken into account.
+	Max float64
+}
+
+// At returns the acceptable rating difference after waiting for the duration.
+func (w Window) At(wait time.Duration) float64 {
+	if w.Max <= 0 {
+		return math.Inf(1)
+	}
+	return math.Min(w.Initial+w.Growth*wait.Seconds(), w.Max)
+}
+
+// Queue is a queue of the players waiting for a match.
+// Queue is not safe for concurrent use.
+type Queue struct {
+	// Window limits the rating difference of the matched players.
+	Window Window
+
+	tickets []*Ticket
+}
+
+// Add puts the ticket to the end of the queue.
+func (q *Queue) Add(t *Ticket) {
+	q.tickets = append(q.tickets, t)
+}
+
+// Remove removes the ticket from the queue.
+// It reports whether the ticket was in the queue.
+func (q *Queue) Remove(t *Ticket) bool {
+	for i, qt := range q.tickets {
+		if qt == t {
+			q.tickets = append(q.tickets[:i], q.tickets[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of tickets in the queue.
+func (q *Queue) Len() int {
+	return len(q.tickets)
+}
+
+// Position returns the 1-based position of the ticket
+// among the tickets of the same match preferences, 0 if the ticket is not in the queue.
+func (q *Queue) Position(t *Ticket) int {
+	pos := 0
+	for _, qt := range q.tickets {
+		if !qt.compatible(t) {
+			continue
+		}
+		pos++
+		if qt == t {
+			return pos
+		}
+	}
+	return 0
+}
+
+// Match removes the groups of compatible tickets from the queue and returns them.
+// The longest waiting players are matched first with the players of the closest ratings,
+// the rating spread of a group does not exceed the rating window of any of its players at the time.
+func (q *Queue) Match(now time.Time) [][]*Ticket {
+	var matches [][]*Ticket
+
+	matched := make(map[*Ticket]bool)
+	for i, t := range q.tickets {
+		if matched[t] {
+			continue
+		}
+
+		var candidates []*Ticket
+		for _, o := range q.tickets[i+1:] {
+			if !matched[o] && o.compatible(t) {
+				candidates = append(candidates, o)
+			}
+		}
+		sort.SliceStable(candidates, func(a, b int) bool {
+			return math.Abs(candidates[a].Rating-t.Rating) < math.Abs(candidates[b].Rating-t.Rating)
+		})
+
+		group := []*Ticket{t}
+		lo, hi := t.Rating, t.Rating
+		window := q.Window.At(now.Sub(t.Since))
+		for _, o := range candidates {
+			if len(group) == t.Size {
+				break
+			}
+			olo, ohi := math.Min(lo, o.Rating), math.Max(hi, o.Rating)
+			ow := math.Min(window, q.Window.At(now.Sub(o.Since)))
+			if ohi-olo > ow {
+				continue
+			}
+			group = append(group, o)
+			lo, hi, window = olo, ohi, ow
+		}
+		if len(group) < t.Size {
+			continue
+		}
+
+		for _, gt := range group {
+			matched[gt] = true
+		}
+		matches = append(matches, group)
+	}
+
+	if len(matches) > 0 {
+		rest := q.tickets[:0]
+		for _, t := range q.tickets {
+			if !matched[t] {
+				rest = append(rest, t)
+			}
+		}
+		q.tickets = rest
+	}
+
+	return matches
+}
@@ -0,0 +1,210 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package matchmaking
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestWindowAt(t *testing.T) {
+	tests := []struct {
+		name   string
+		window Window
+		wait   time.Duration
+		want   float64
+	}{
+		{"ratings ignored", Window{}, time.Minute, math.Inf(1)},
+		{"initial", Window{Initial: 100, Growth: 10, Max: 400}, 0, 100},
+		{"growing", Window{Initial: 100, Growth: 10, Max: 400}, 5 * time.Second, 150},
+		{"capped", Window{Initial: 100, Growth: 10, Max: 400}, time.Minute, 400},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.At(tt.wait); got != tt.want {
+				t.Errorf("At(%v) = %v, want %v", tt.wait, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueueMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  Window
+		tickets []*Ticket
+		after   time.Duration
+		want    [][]string
+	}{
+		{
+			name: "first come first served",
+			tickets: []*Ticket{
+				{PlayerID: "a", Size: 2},
+				{PlayerID: "b", Size: 2},
+				{PlayerID: "c", Size: 2},
+			},
+			want: [][]string{{"a", "b"}},
+		},
+		{
+			name: "incompatible preferences",
+			tickets: []*Ticket{
+				{PlayerID: "a", Size: 2, Ruleset: "classic"},
+				{PlayerID: "b", Size: 3, Ruleset: "classic"},
+				{PlayerID: "c", Size: 2, Ruleset: "rpsls"},
+				{PlayerID: "d", Size: 2, Ruleset: "classic"},
+			},
+			want: [][]string{{"a", "d"}},
+		},
+		{
+			name: "not enough players",
+			tickets: []*Ticket{
+				{PlayerID: "a", Size: 3},
+				{PlayerID: "b", Size: 3},
+			},
+		},
+		{
+			name: "closest rating",
+			tickets: []*Ticket{
+				{PlayerID: "a", Size: 2, Rating: 1500},
+				{PlayerID: "b", Size: 2, Rating: 1900},
+				{PlayerID: "c", Size: 2, Rating: 1550},
+			},
+			want: [][]string{{"a", "c"}},
+		},
+		{
+			name:   "outside the window",
+			window: Window{Initial: 100, Growth: 10, Max: 1000},
+			tickets: []*Ticket{
+				{PlayerID: "a", Size: 2, Rating: 1500},
+				{PlayerID: "b", Size: 2, Rating: 1700},
+			},
+		},
+		{
+			name:   "window grows with waiting",
+			window: Window{Initial: 100, Growth: 10, Max: 1000},
+			tickets: []*Ticket{
+				{PlayerID: "a", Size: 2, Rating: 1500},
+				{PlayerID: "b", Size: 2, Rating: 1700},
+			},
+			after: 10 * time.Second,
+			want:  [][]string{{"a", "b"}},
+		},
+		{
+			name:   "window of the newcomer",
+			window: Window{Initial: 100, Growth: 10, Max: 1000},
+			tickets: []*Ticket{
+				{PlayerID: "a", Size: 2, Rating: 1500, Since: epoch.Add(-time.Minute)},
+				{PlayerID: "b", Size: 2, Rating: 1700},
+			},
+		},
+		{
+			name:   "window is capped",
+			window: Window{Initial: 100, Growth: 10, Max: 150},
+			tickets: []*Ticket{
+				{PlayerID: "a", Size: 2, Rating: 1500},
+				{PlayerID: "b", Size: 2, Rating: 1700},
+			},
+			after: time.Hour,
+		},
+		{
+			name:   "group spread",
+			window: Window{Initial: 100, Max: 100},
+			tickets: []*Ticket{
+				{PlayerID: "a", Size: 3, Rating: 1500},
+				{PlayerID: "b", Size: 3, Rating: 1420},
+				{PlayerID: "c", Size: 3, Rating: 1580},
+				{PlayerID: "d", Size: 3, Rating: 1560},
+			},
+			want: [][]string{{"a", "d", "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := Queue{Window: tt.window}
+			for _, tk := range tt.tickets {
+				if tk.Since.IsZero() {
+					tk.Since = epoch
+				}
+				q.Add(tk)
+			}
+
+			got := q.Match(epoch.Add(tt.after))
+			if len(got) != len(tt.want) {
+				t.Fatalf("Match() = %v, want %v", ids(got), tt.want)
+			}
+			matched := 0
+			for i, g := range got {
+				if len(g) != len(tt.want[i]) {
+					t.Fatalf("Match() = %v, want %v", ids(got), tt.want)
+				}
+				for j, tk := range g {
+					if tk.PlayerID != tt.want[i][j] {
+						t.Fatalf("Match() = %v, want %v", ids(got), tt.want)
+					}
+				}
+				matched += len(g)
+			}
+			if q.Len() != len(tt.tickets)-matched {
+				t.Errorf("Len() = %d after the match, want %d", q.Len(), len(tt.tickets)-matched)
+			}
+		})
+	}
+}
+
+func TestQueuePosition(t *testing.T) {
+	var q Queue
+	a := &Ticket{PlayerID: "a", Size: 2}
+	b := &Ticket{PlayerID: "b", Size: 3}
+	c := &Ticket{PlayerID: "c", Size: 2}
+	q.Add(a)
+	q.Add(b)
+	q.Add(c)
+
+	if got := q.Position(c); got != 2 {
+		t.Errorf("Position(c) = %d, want 2", got)
+	}
+	if got := q.Position(b); got != 1 {
+		t.Errorf("Position(b) = %d, want 1", got)
+	}
+	if !q.Remove(a) {
+		t.Fatal("Remove(a) = false")
+	}
+	if q.Remove(a) {
+		t.Error("Remove(a) twice = true")
+	}
+	if got := q.Position(a); got != 0 {
+		t.Errorf("Position(a) = %d after the removal, want 0", got)
+	}
+	if got := q.Position(c); got != 1 {
+		t.Errorf("Position(c) = %d, want 1", got)
+	}
+}
+
+func ids(matches [][]*Ticket) [][]string {
+	out := make([][]string, len(matches))
+	for i, g := range matches {
+		for _, t := range g {
+			out[i] = append(out[i], t.PlayerID)
+		}
+	}
+	return out
+}
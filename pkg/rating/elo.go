@@ -0,0 +1,46 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package rating
+
+import "math"
+
+// Elo is the Elo rating system.
+type Elo struct {
+	// K is the maximum rating change per game.
+	K float64
+}
+
+// Name implements System.
+func (Elo) Name() string {
+	return "elo"
+}
+
+// Initial implements System.
+func (Elo) Initial() Rating {
+	return Rating{Value: 1500}
+}
+
+// Rate implements System.
+func (e Elo) Rate(player Rating, games []Game) Rating {
+	r := player
+	for _, g := range games {
+		expected := 1 / (1 + math.Pow(10, (g.Opponent.Value-player.Value)/400))
+		r.Value += e.K * (g.Score - expected)
+	}
+	return r
+}
@@ -0,0 +1,94 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package rating
+
+import (
+	"math"
+	"testing"
+)
+
+func TestElo(t *testing.T) {
+	tests := []struct {
+		name   string
+		k      float64
+		player float64
+		games  []Game
+		want   float64
+	}{
+		{
+			name:   "even",
+			k:      32,
+			player: 1500,
+			games:  []Game{{Opponent: Rating{Value: 1500}, Score: 1}},
+			want:   1516,
+		},
+		{
+			name:   "upset",
+			k:      32,
+			player: 1500,
+			games:  []Game{{Opponent: Rating{Value: 1900}, Score: 1}},
+			want:   1500 + 32*10.0/11,
+		},
+		{
+			name:   "draw",
+			k:      20,
+			player: 1600,
+			games:  []Game{{Opponent: Rating{Value: 1200}, Score: 0.5}},
+			want:   1600 + 20*(0.5-10.0/11),
+		},
+		{
+			name:   "several games",
+			k:      32,
+			player: 1500,
+			games: []Game{
+				{Opponent: Rating{Value: 1500}, Score: 1},
+				{Opponent: Rating{Value: 1500}, Score: 0},
+			},
+			want: 1500,
+		},
+		{
+			name:   "no games",
+			k:      32,
+			player: 1500,
+			want:   1500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Elo{K: tt.k}.Rate(Rating{Value: tt.player}, tt.games)
+			if math.Abs(got.Value-tt.want) > 0.000001 {
+				t.Errorf("Rate() = %v, want %v", got.Value, tt.want)
+			}
+		})
+	}
+}
+func TestRateGame(t *testing.T) {
+	sys := Elo{K: 32}
+	ratings := map[string]Rating{"a": sys.Initial(), "b": sys.Initial(), "c": sys.Initial()}
+	updated := Rate(sys, ratings, map[string]int32{"a": 3, "b": 1, "c": 1})
+
+	if got := updated["a"].Value; got != 1532 {
+		t.Errorf("rating of the winner = %v, want 1532", got)
+	}
+	for _, id := range []string{"b", "c"} {
+		if got := updated[id].Value; got != 1484 {
+			t.Errorf("rating of %s = %v, want 1484", id, got)
+		}
+	}
+}
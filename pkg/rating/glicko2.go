@@ -0,0 +1,164 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package rating
+
+import (
+	"math"
+	"time"
+)
+
+// glicko2Scale converts the Glicko rating scale to the Glicko-2 scale.
+const glicko2Scale = 173.7178
+
+// Glicko2 is the Glicko-2 rating system by Mark Glickman.
+// Every rated game is a rating period of its own,
+// the deviation of a player who does not play grows by a rating period every Period.
+type Glicko2 struct {
+	// Tau constrains the change of the volatility, reasonable values are between 0.3 and 1.2.
+	Tau float64
+
+	// Period is the duration of an idle rating period, 0 means the deviation never grows while idle.
+	Period time.Duration
+}
+
+// Decayer is implemented by the rating systems
+// whose uncertainty of a rating grows while the player does not play.
+type Decayer interface {
+	// Decay returns the player's rating after the idle time.
+	Decay(r Rating, idle time.Duration) Rating
+}
+
+// Name implements System.
+func (Glicko2) Name() string {
+	return "glicko2"
+}
+
+// Initial implements System.
+func (Glicko2) Initial() Rating {
+	return Rating{Value: 1500, Deviation: 350, Volatility: 0.06}
+}
+
+// Decay implements Decayer.
+func (g Glicko2) Decay(r Rating, idle time.Duration) Rating {
+	r = g.complete(r)
+	if g.Period <= 0 {
+		return r
+	}
+
+	phi := r.Deviation / glicko2Scale
+	for n := idle / g.Period; n > 0; n-- {
+		phi = math.Sqrt(phi*phi + r.Volatility*r.Volatility)
+	}
+	r.Deviation = math.Min(phi*glicko2Scale, g.Initial().Deviation)
+
+	return r
+}
+
+// Rate implements System.
+func (g Glicko2) Rate(player Rating, games []Game) Rating {
+	player = g.complete(player)
+
+	mu := (player.Value - 1500) / glicko2Scale
+	phi := player.Deviation / glicko2Scale
+	sigma := player.Volatility
+
+	if len(games) == 0 {
+		player.Deviation = math.Sqrt(phi*phi+sigma*sigma) * glicko2Scale
+		return player
+	}
+
+	var v, delta float64
+	for _, game := range games {
+		o := g.complete(game.Opponent)
+		muj := (o.Value - 1500) / glicko2Scale
+		gj := glickoG(o.Deviation / glicko2Scale)
+		e := 1 / (1 + math.Exp(-gj*(mu-muj)))
+		v += gj * gj * e * (1 - e)
+		delta += gj * (game.Score - e)
+	}
+	v = 1 / v
+	improvement := delta
+	delta *= v
+
+	sigma = g.volatility(phi, sigma, v, delta)
+
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	phi = 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	mu += phi * phi * improvement
+
+	return Rating{
+		Value:      mu*glicko2Scale + 1500,
+		Deviation:  phi * glicko2Scale,
+		Volatility: sigma,
+	}
+}
+
+// volatility computes the new volatility by the Illinois algorithm.
+func (g Glicko2) volatility(phi, sigma, v, delta float64) float64 {
+	const epsilon = 0.000001
+
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(g.Tau*g.Tau)
+	}
+
+	A := a
+	var B float64
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*g.Tau) < 0 {
+			k++
+		}
+		B = a - k*g.Tau
+	}
+
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > epsilon {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2
+		}
+		B, fB = C, fC
+	}
+
+	return math.Exp(A / 2)
+}
+
+// complete fills the deviation and the volatility of a rating from another system with the initial ones.
+func (g Glicko2) complete(r Rating) Rating {
+	initial := g.Initial()
+	if r.Deviation <= 0 {
+		r.Deviation = initial.Deviation
+	}
+	if r.Volatility <= 0 {
+		r.Volatility = initial.Volatility
+	}
+	return r
+}
+
+// glickoG weighs the impact of a game by the opponent's deviation.
+func glickoG(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
@@ -0,0 +1,91 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package rating
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGlicko2(t *testing.T) {
+	tests := []struct {
+		name   string
+		system System
+		player Rating
+		games  []Game
+		want   Rating
+		tol    Rating
+	}{
+		{
+			// The example of "Example of the Glicko-2 system" by Mark Glickman.
+			name:   "reference",
+			system: Glicko2{Tau: 0.5},
+			player: Rating{Value: 1500, Deviation: 200, Volatility: 0.06},
+			games: []Game{
+				{Opponent: Rating{Value: 1400, Deviation: 30}, Score: 1},
+				{Opponent: Rating{Value: 1550, Deviation: 100}, Score: 0},
+				{Opponent: Rating{Value: 1700, Deviation: 300}, Score: 0},
+			},
+			want: Rating{Value: 1464.05, Deviation: 151.52, Volatility: 0.059996},
+			tol:  Rating{Value: 0.01, Deviation: 0.01, Volatility: 0.000001},
+		},
+		{
+			name:   "no games",
+			system: Glicko2{Tau: 0.5},
+			player: Rating{Value: 1500, Deviation: 200, Volatility: 0.06},
+			want:   Rating{Value: 1500, Deviation: 200.27, Volatility: 0.06},
+			tol:    Rating{Value: 0.01, Deviation: 0.01, Volatility: 0.000001},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.system.Rate(tt.player, tt.games)
+			if math.Abs(got.Value-tt.want.Value) > tt.tol.Value ||
+				math.Abs(got.Deviation-tt.want.Deviation) > tt.tol.Deviation ||
+				math.Abs(got.Volatility-tt.want.Volatility) > tt.tol.Volatility {
+				t.Errorf("Rate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGlicko2Decay(t *testing.T) {
+	g := Glicko2{Tau: 0.5, Period: time.Hour}
+	r := Rating{Value: 1700, Deviation: 50, Volatility: 0.06}
+
+	if got := g.Decay(r, 30*time.Minute); got != r {
+		t.Errorf("Decay() within a period = %+v, want %+v", got, r)
+	}
+
+	got := g.Decay(r, 2*time.Hour)
+	phi := 50 / glicko2Scale
+	want := math.Sqrt(phi*phi+2*0.06*0.06) * glicko2Scale
+	if got.Value != r.Value || math.Abs(got.Deviation-want) > 0.000001 {
+		t.Errorf("Decay() of 2 periods = %+v, want deviation %v", got, want)
+	}
+
+	if got := g.Decay(r, 1000000*time.Hour); got.Deviation != g.Initial().Deviation {
+		t.Errorf("Decay() of a long idle time = %+v, want the initial deviation", got)
+	}
+
+	if got := (Glicko2{Tau: 0.5}).Decay(r, 1000*time.Hour); got != r {
+		t.Errorf("Decay() with no period = %+v, want %+v", got, r)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package rating implements skill rating systems of the players.
+package rating
+
+// Rating is a player's skill rating.
+type Rating struct {
+	// Value is the rating value.
+	Value float64
+
+	// Deviation is the uncertainty of the rating value, if the system tracks it.
+	Deviation float64
+
+	// Volatility is the expected fluctuation of the rating value, if the system tracks it.
+	Volatility float64
+}
+
+// Game is the result of a game against an opponent.
+type Game struct {
+	// Opponent is the opponent's rating before the game.
+	Opponent Rating
+
+	// Score is 1 for a win, 0.5 for a draw and 0 for a loss.
+	Score float64
+}
+
+// System is a rating system.
+type System interface {
+	// Name returns the system name.
+	Name() string
+
+	// Initial returns the rating of a new player.
+	Initial() Rating
+
+	// Rate returns the player's rating after the games played in a rating period.
+	Rate(player Rating, games []Game) Rating
+}
+
+// Rate rates the players of a multi-player game as the pairwise results:
+// a player beats every opponent of a lower score and draws with the opponents of the same score.
+// It returns the new ratings of the players.
+func Rate(sys System, ratings map[string]Rating, scores map[string]int32) map[string]Rating {
+	updated := make(map[string]Rating, len(scores))
+	for a, sa := range scores {
+		var games []Game
+		for b, sb := range scores {
+			if a == b {
+				continue
+			}
+			g := Game{Opponent: ratings[b], Score: 0.5}
+			switch {
+			case sa > sb:
+				g.Score = 1
+			case sa < sb:
+				g.Score = 0
+			}
+			games = append(games, g)
+		}
+		updated[a] = sys.Rate(ratings[a], games)
+	}
+	return updated
+}
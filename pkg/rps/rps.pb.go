@@ -10,6 +10,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -26,6 +27,7 @@ const (
 const _ = proto.ProtoPackageIsVersion4
 
 // EnumChoise is possible choise a player can make.
+// Weapons of custom rulesets are picked by the values following the listed ones.
 type EnumChoise int32
 
 const (
@@ -34,21 +36,63 @@ const (
 	EnumChoise_Stone         EnumChoise = 1
 	EnumChoise_Scissors      EnumChoise = 2
 	EnumChoise_Paper         EnumChoise = 3
+	EnumChoise_Lizard        EnumChoise = 4
+	EnumChoise_Spock         EnumChoise = 5
+	EnumChoise_Fire          EnumChoise = 6
+	EnumChoise_Snake         EnumChoise = 7
+	EnumChoise_Human         EnumChoise = 8
+	EnumChoise_Tree          EnumChoise = 9
+	EnumChoise_Wolf          EnumChoise = 10
+	EnumChoise_Sponge        EnumChoise = 11
+	EnumChoise_Air           EnumChoise = 12
+	EnumChoise_Water         EnumChoise = 13
+	EnumChoise_Dragon        EnumChoise = 14
+	EnumChoise_Devil         EnumChoise = 15
+	EnumChoise_Lightning     EnumChoise = 16
+	EnumChoise_Gun           EnumChoise = 17
 )
 
 // Enum value maps for EnumChoise.
 var (
 	EnumChoise_name = map[int32]string{
-		0: "UnknownChoise",
-		1: "Stone",
-		2: "Scissors",
-		3: "Paper",
+		0:  "UnknownChoise",
+		1:  "Stone",
+		2:  "Scissors",
+		3:  "Paper",
+		4:  "Lizard",
+		5:  "Spock",
+		6:  "Fire",
+		7:  "Snake",
+		8:  "Human",
+		9:  "Tree",
+		10: "Wolf",
+		11: "Sponge",
+		12: "Air",
+		13: "Water",
+		14: "Dragon",
+		15: "Devil",
+		16: "Lightning",
+		17: "Gun",
 	}
 	EnumChoise_value = map[string]int32{
 		"UnknownChoise": 0,
 		"Stone":         1,
 		"Scissors":      2,
 		"Paper":         3,
+		"Lizard":        4,
+		"Spock":         5,
+		"Fire":          6,
+		"Snake":         7,
+		"Human":         8,
+		"Tree":          9,
+		"Wolf":          10,
+		"Sponge":        11,
+		"Air":           12,
+		"Water":         13,
+		"Dragon":        14,
+		"Devil":         15,
+		"Lightning":     16,
+		"Gun":           17,
 	}
 )
 
@@ -132,14 +176,308 @@ func (EnumStatus) EnumDescriptor() ([]byte, []int) {
 	return file_rps_proto_rawDescGZIP(), []int{1}
 }
 
+// EnumRoomState is a game state of a room.
+type EnumRoomState int32
+
+const (
+	EnumRoomState_UnknownRoomState EnumRoomState = 0
+	// Waiting means the room waits for the players to get ready.
+	EnumRoomState_Waiting EnumRoomState = 1
+	// Playing means the game is in progress.
+	EnumRoomState_Playing EnumRoomState = 2
+	// Over means the game is over.
+	EnumRoomState_Over EnumRoomState = 3
+)
+
+// Enum value maps for EnumRoomState.
+var (
+	EnumRoomState_name = map[int32]string{
+		0: "UnknownRoomState",
+		1: "Waiting",
+		2: "Playing",
+		3: "Over",
+	}
+	EnumRoomState_value = map[string]int32{
+		"UnknownRoomState": 0,
+		"Waiting":          1,
+		"Playing":          2,
+		"Over":             3,
+	}
+)
+
+func (x EnumRoomState) Enum() *EnumRoomState {
+	p := new(EnumRoomState)
+	*p = x
+	return p
+}
+
+func (x EnumRoomState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumRoomState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rps_proto_enumTypes[2].Descriptor()
+}
+
+func (EnumRoomState) Type() protoreflect.EnumType {
+	return &file_rps_proto_enumTypes[2]
+}
+
+func (x EnumRoomState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumRoomState.Descriptor instead.
+func (EnumRoomState) EnumDescriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{2}
+}
+
+// EnumPeriod is a time window of the completed games.
+type EnumPeriod int32
+
+const (
+	// AllTime means all the games.
+	EnumPeriod_AllTime EnumPeriod = 0
+	// Daily means the games of the last 24 hours.
+	EnumPeriod_Daily EnumPeriod = 1
+	// Weekly means the games of the last 7 days.
+	EnumPeriod_Weekly EnumPeriod = 2
+)
+
+// Enum value maps for EnumPeriod.
+var (
+	EnumPeriod_name = map[int32]string{
+		0: "AllTime",
+		1: "Daily",
+		2: "Weekly",
+	}
+	EnumPeriod_value = map[string]int32{
+		"AllTime": 0,
+		"Daily":   1,
+		"Weekly":  2,
+	}
+)
+
+func (x EnumPeriod) Enum() *EnumPeriod {
+	p := new(EnumPeriod)
+	*p = x
+	return p
+}
+
+func (x EnumPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_rps_proto_enumTypes[3].Descriptor()
+}
+
+func (EnumPeriod) Type() protoreflect.EnumType {
+	return &file_rps_proto_enumTypes[3]
+}
+
+func (x EnumPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumPeriod.Descriptor instead.
+func (EnumPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{3}
+}
+
+// EnumLeaderboardSort is an order of the leaderboard players, the best first.
+type EnumLeaderboardSort int32
+
+const (
+	// ByRating orders the players by the rating.
+	EnumLeaderboardSort_ByRating EnumLeaderboardSort = 0
+	// ByWins orders the players by the number of won games.
+	EnumLeaderboardSort_ByWins EnumLeaderboardSort = 1
+	// ByWinRate orders the players by the share of won games.
+	EnumLeaderboardSort_ByWinRate EnumLeaderboardSort = 2
+	// ByGames orders the players by the number of played games.
+	EnumLeaderboardSort_ByGames EnumLeaderboardSort = 3
+)
+
+// Enum value maps for EnumLeaderboardSort.
+var (
+	EnumLeaderboardSort_name = map[int32]string{
+		0: "ByRating",
+		1: "ByWins",
+		2: "ByWinRate",
+		3: "ByGames",
+	}
+	EnumLeaderboardSort_value = map[string]int32{
+		"ByRating":  0,
+		"ByWins":    1,
+		"ByWinRate": 2,
+		"ByGames":   3,
+	}
+)
+
+func (x EnumLeaderboardSort) Enum() *EnumLeaderboardSort {
+	p := new(EnumLeaderboardSort)
+	*p = x
+	return p
+}
+
+func (x EnumLeaderboardSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumLeaderboardSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_rps_proto_enumTypes[4].Descriptor()
+}
+
+func (EnumLeaderboardSort) Type() protoreflect.EnumType {
+	return &file_rps_proto_enumTypes[4]
+}
+
+func (x EnumLeaderboardSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumLeaderboardSort.Descriptor instead.
+func (EnumLeaderboardSort) EnumDescriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{4}
+}
+
+type EnumBracket int32
+
+const (
+	// NoBracket is the bracket of the tournaments with no elimination.
+	EnumBracket_NoBracket EnumBracket = 0
+	// WinnersBracket is the bracket of the players who have not lost yet.
+	EnumBracket_WinnersBracket EnumBracket = 1
+	// LosersBracket is the bracket of the players who have lost once in a double elimination.
+	EnumBracket_LosersBracket EnumBracket = 2
+	// GrandFinal is the match of the winners of both brackets in a double elimination.
+	EnumBracket_GrandFinal EnumBracket = 3
+)
+
+// Enum value maps for EnumBracket.
+var (
+	EnumBracket_name = map[int32]string{
+		0: "NoBracket",
+		1: "WinnersBracket",
+		2: "LosersBracket",
+		3: "GrandFinal",
+	}
+	EnumBracket_value = map[string]int32{
+		"NoBracket":      0,
+		"WinnersBracket": 1,
+		"LosersBracket":  2,
+		"GrandFinal":     3,
+	}
+)
+
+func (x EnumBracket) Enum() *EnumBracket {
+	p := new(EnumBracket)
+	*p = x
+	return p
+}
+
+func (x EnumBracket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumBracket) Descriptor() protoreflect.EnumDescriptor {
+	return file_rps_proto_enumTypes[5].Descriptor()
+}
+
+func (EnumBracket) Type() protoreflect.EnumType {
+	return &file_rps_proto_enumTypes[5]
+}
+
+func (x EnumBracket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumBracket.Descriptor instead.
+func (EnumBracket) EnumDescriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{5}
+}
+
+type EnumTournamentState int32
+
+const (
+	EnumTournamentState_UnknownTournamentState EnumTournamentState = 0
+	// Registering means the players can join the tournament.
+	EnumTournamentState_Registering EnumTournamentState = 1
+	// Running means the matches are being played.
+	EnumTournamentState_Running EnumTournamentState = 2
+	// Finished means all the matches are finished.
+	EnumTournamentState_Finished EnumTournamentState = 3
+)
+
+// Enum value maps for EnumTournamentState.
+var (
+	EnumTournamentState_name = map[int32]string{
+		0: "UnknownTournamentState",
+		1: "Registering",
+		2: "Running",
+		3: "Finished",
+	}
+	EnumTournamentState_value = map[string]int32{
+		"UnknownTournamentState": 0,
+		"Registering":            1,
+		"Running":                2,
+		"Finished":               3,
+	}
+)
+
+func (x EnumTournamentState) Enum() *EnumTournamentState {
+	p := new(EnumTournamentState)
+	*p = x
+	return p
+}
+
+func (x EnumTournamentState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumTournamentState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rps_proto_enumTypes[6].Descriptor()
+}
+
+func (EnumTournamentState) Type() protoreflect.EnumType {
+	return &file_rps_proto_enumTypes[6]
+}
+
+func (x EnumTournamentState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumTournamentState.Descriptor instead.
+func (EnumTournamentState) EnumDescriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{6}
+}
+
 // AuthRequest is a player's authentication requst message.
 type AuthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name is a player name.
+	// Name is a player name, every player has a unique one.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Reconnect asks to return the player registered with the name before
+	// instead of failing with ALREADY_EXISTS.
+	// A player who registered with credentials has to prove them to reconnect:
+	// either the password or the signature of a challenge.
+	Reconnect bool `protobuf:"varint,2,opt,name=reconnect,proto3" json:"reconnect,omitempty"`
+	// Password is the player's password to register with or to reconnect with.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// PublicKey is the player's ed25519 public key to register with.
+	PublicKey []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Signature is the ed25519 signature of the challenge to reconnect with.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// ChallengeId is an ID of the signed challenge.
+	ChallengeId string `protobuf:"bytes,6,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// OwnerToken is the session token of the player who registers the new player as a bot,
+	// the bot is owned by that player. A player with a client certificate owns the bots
+	// the player registers with the certificate.
+	OwnerToken string `protobuf:"bytes,7,opt,name=owner_token,json=ownerToken,proto3" json:"owner_token,omitempty"`
 }
 
 func (x *AuthRequest) Reset() {
@@ -181,18 +519,60 @@ func (x *AuthRequest) GetName() string {
 	return ""
 }
 
-// AuthResponse is a player's authentication response message.
-type AuthResponse struct {
+func (x *AuthRequest) GetReconnect() bool {
+	if x != nil {
+		return x.Reconnect
+	}
+	return false
+}
+
+func (x *AuthRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AuthRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *AuthRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *AuthRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *AuthRequest) GetOwnerToken() string {
+	if x != nil {
+		return x.OwnerToken
+	}
+	return ""
+}
+
+// ChallengeRequest is a request of a challenge to sign.
+type ChallengeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Id is a player ID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is the name of the player who registered with a public key.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *AuthResponse) Reset() {
-	*x = AuthResponse{}
+func (x *ChallengeRequest) Reset() {
+	*x = ChallengeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rps_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -200,13 +580,13 @@ func (x *AuthResponse) Reset() {
 	}
 }
 
-func (x *AuthResponse) String() string {
+func (x *ChallengeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthResponse) ProtoMessage() {}
+func (*ChallengeRequest) ProtoMessage() {}
 
-func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+func (x *ChallengeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rps_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -218,30 +598,34 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
-func (*AuthResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChallengeRequest.ProtoReflect.Descriptor instead.
+func (*ChallengeRequest) Descriptor() ([]byte, []int) {
 	return file_rps_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *AuthResponse) GetId() string {
+func (x *ChallengeRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-// ReadyRequest is a player's ready request.
-type ReadyRequest struct {
+// ChallengeResponse is a challenge to sign.
+type ChallengeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// PlayerId is an ID of a player.
-	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Challenge is the random bytes to sign, it can be used once within the challenge timeout.
+	Challenge []byte `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// TimeoutSeconds is the challenge timeout.
+	TimeoutSeconds int32 `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// ChallengeId is an ID of the challenge to send along with its signature.
+	ChallengeId string `protobuf:"bytes,3,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
 }
 
-func (x *ReadyRequest) Reset() {
-	*x = ReadyRequest{}
+func (x *ChallengeResponse) Reset() {
+	*x = ChallengeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rps_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -249,13 +633,13 @@ func (x *ReadyRequest) Reset() {
 	}
 }
 
-func (x *ReadyRequest) String() string {
+func (x *ChallengeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadyRequest) ProtoMessage() {}
+func (*ChallengeResponse) ProtoMessage() {}
 
-func (x *ReadyRequest) ProtoReflect() protoreflect.Message {
+func (x *ChallengeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rps_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -267,30 +651,50 @@ func (x *ReadyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadyRequest.ProtoReflect.Descriptor instead.
-func (*ReadyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChallengeResponse.ProtoReflect.Descriptor instead.
+func (*ChallengeResponse) Descriptor() ([]byte, []int) {
 	return file_rps_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ReadyRequest) GetPlayerId() string {
+func (x *ChallengeResponse) GetChallenge() []byte {
 	if x != nil {
-		return x.PlayerId
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *ChallengeResponse) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ChallengeResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
 	}
 	return ""
 }
 
-// ReadyResponse is a player's ready request response.
-type ReadyResponse struct {
+// AuthResponse is a player's authentication response message.
+type AuthResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ChoiseTimeoutSeconds is a timeout for player's choise in seconds.
-	ChoiseTimeoutSeconds int32 `protobuf:"varint,1,opt,name=choise_timeout_seconds,json=choiseTimeoutSeconds,proto3" json:"choise_timeout_seconds,omitempty"`
+	// Id is a player ID, it is stable across reconnects and server restarts.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Player is the authenticated player.
+	Player *Player `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	// Token is the session token of the player.
+	// The other calls which act on behalf of the player pass it in the authorization metadata
+	// as "Bearer <token>" and may leave player_id empty.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *ReadyResponse) Reset() {
-	*x = ReadyResponse{}
+func (x *AuthResponse) Reset() {
+	*x = AuthResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rps_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,13 +702,13 @@ func (x *ReadyResponse) Reset() {
 	}
 }
 
-func (x *ReadyResponse) String() string {
+func (x *AuthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadyResponse) ProtoMessage() {}
+func (*AuthResponse) ProtoMessage() {}
 
-func (x *ReadyResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rps_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -316,32 +720,47 @@ func (x *ReadyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadyResponse.ProtoReflect.Descriptor instead.
-func (*ReadyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
+func (*AuthResponse) Descriptor() ([]byte, []int) {
 	return file_rps_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ReadyResponse) GetChoiseTimeoutSeconds() int32 {
+func (x *AuthResponse) GetId() string {
 	if x != nil {
-		return x.ChoiseTimeoutSeconds
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-// Choise is what a player chose.
-type Choise struct {
+func (x *AuthResponse) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *AuthResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// ReadyRequest is a player's ready request.
+type ReadyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Choise is a player choise.
-	Choise EnumChoise `protobuf:"varint,1,opt,name=choise,proto3,enum=rps.EnumChoise" json:"choise,omitempty"`
-	// Player who made a choise.
-	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// PlayerId is an ID of a player.
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// RoomId is an ID of the room the player joined.
+	// The player joins the default room if it is empty.
+	RoomId string `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 }
 
-func (x *Choise) Reset() {
-	*x = Choise{}
+func (x *ReadyRequest) Reset() {
+	*x = ReadyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rps_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -349,13 +768,13 @@ func (x *Choise) Reset() {
 	}
 }
 
-func (x *Choise) String() string {
+func (x *ReadyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Choise) ProtoMessage() {}
+func (*ReadyRequest) ProtoMessage() {}
 
-func (x *Choise) ProtoReflect() protoreflect.Message {
+func (x *ReadyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rps_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -367,37 +786,43 @@ func (x *Choise) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Choise.ProtoReflect.Descriptor instead.
-func (*Choise) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadyRequest.ProtoReflect.Descriptor instead.
+func (*ReadyRequest) Descriptor() ([]byte, []int) {
 	return file_rps_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Choise) GetChoise() EnumChoise {
+func (x *ReadyRequest) GetPlayerId() string {
 	if x != nil {
-		return x.Choise
+		return x.PlayerId
 	}
-	return EnumChoise_UnknownChoise
+	return ""
 }
 
-func (x *Choise) GetPlayerId() string {
+func (x *ReadyRequest) GetRoomId() string {
 	if x != nil {
-		return x.PlayerId
+		return x.RoomId
 	}
 	return ""
 }
 
-// Score reports the latest round results and the current results of the game.
-type Score struct {
+// ReadyResponse is a player's ready request response.
+type ReadyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoundResults []*RoundResult `protobuf:"bytes,1,rep,name=round_results,json=roundResults,proto3" json:"round_results,omitempty"`
-	GameResults  []*GameResult  `protobuf:"bytes,2,rep,name=game_results,json=gameResults,proto3" json:"game_results,omitempty"`
+	// ChoiseTimeoutSeconds is a timeout for player's choise in seconds.
+	ChoiseTimeoutSeconds int32 `protobuf:"varint,1,opt,name=choise_timeout_seconds,json=choiseTimeoutSeconds,proto3" json:"choise_timeout_seconds,omitempty"`
+	// Ruleset is the name of the game ruleset.
+	Ruleset string `protobuf:"bytes,2,opt,name=ruleset,proto3" json:"ruleset,omitempty"`
+	// Weapons are the choises a player can make in the game.
+	Weapons []*Weapon `protobuf:"bytes,3,rep,name=weapons,proto3" json:"weapons,omitempty"`
+	// FairPlay means the players commit to their choises before revealing them.
+	FairPlay bool `protobuf:"varint,4,opt,name=fair_play,json=fairPlay,proto3" json:"fair_play,omitempty"`
 }
 
-func (x *Score) Reset() {
-	*x = Score{}
+func (x *ReadyResponse) Reset() {
+	*x = ReadyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rps_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,13 +830,13 @@ func (x *Score) Reset() {
 	}
 }
 
-func (x *Score) String() string {
+func (x *ReadyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Score) ProtoMessage() {}
+func (*ReadyResponse) ProtoMessage() {}
 
-func (x *Score) ProtoReflect() protoreflect.Message {
+func (x *ReadyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rps_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -423,41 +848,48 @@ func (x *Score) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Score.ProtoReflect.Descriptor instead.
-func (*Score) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadyResponse.ProtoReflect.Descriptor instead.
+func (*ReadyResponse) Descriptor() ([]byte, []int) {
 	return file_rps_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Score) GetRoundResults() []*RoundResult {
+func (x *ReadyResponse) GetChoiseTimeoutSeconds() int32 {
 	if x != nil {
-		return x.RoundResults
+		return x.ChoiseTimeoutSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *Score) GetGameResults() []*GameResult {
+func (x *ReadyResponse) GetRuleset() string {
 	if x != nil {
-		return x.GameResults
+		return x.Ruleset
+	}
+	return ""
+}
+
+func (x *ReadyResponse) GetWeapons() []*Weapon {
+	if x != nil {
+		return x.Weapons
 	}
 	return nil
 }
 
-// RoundResult is the latest round result of the player.
-type RoundResult struct {
+func (x *ReadyResponse) GetFairPlay() bool {
+	if x != nil {
+		return x.FairPlay
+	}
+	return false
+}
+
+// RulesetsRequest is a request of the available rulesets.
+type RulesetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Player identifies the player who made the choise.
-	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// Choise is the player choise.
-	Choise EnumChoise `protobuf:"varint,2,opt,name=choise,proto3,enum=rps.EnumChoise" json:"choise,omitempty"`
-	// Status is the result of player choise.
-	Status EnumStatus `protobuf:"varint,3,opt,name=status,proto3,enum=rps.EnumStatus" json:"status,omitempty"`
 }
 
-func (x *RoundResult) Reset() {
-	*x = RoundResult{}
+func (x *RulesetsRequest) Reset() {
+	*x = RulesetsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rps_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,13 +897,13 @@ func (x *RoundResult) Reset() {
 	}
 }
 
-func (x *RoundResult) String() string {
+func (x *RulesetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoundResult) ProtoMessage() {}
+func (*RulesetsRequest) ProtoMessage() {}
 
-func (x *RoundResult) ProtoReflect() protoreflect.Message {
+func (x *RulesetsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rps_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -483,61 +915,149 @@ func (x *RoundResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoundResult.ProtoReflect.Descriptor instead.
-func (*RoundResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use RulesetsRequest.ProtoReflect.Descriptor instead.
+func (*RulesetsRequest) Descriptor() ([]byte, []int) {
 	return file_rps_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RoundResult) GetPlayer() *Player {
+// RulesetsResponse lists the available rulesets.
+type RulesetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rulesets are the available rulesets.
+	Rulesets []*Ruleset `protobuf:"bytes,1,rep,name=rulesets,proto3" json:"rulesets,omitempty"`
+}
+
+func (x *RulesetsResponse) Reset() {
+	*x = RulesetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RulesetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesetsResponse) ProtoMessage() {}
+
+func (x *RulesetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesetsResponse.ProtoReflect.Descriptor instead.
+func (*RulesetsResponse) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RulesetsResponse) GetRulesets() []*Ruleset {
 	if x != nil {
-		return x.Player
+		return x.Rulesets
 	}
 	return nil
 }
 
-func (x *RoundResult) GetChoise() EnumChoise {
+// Ruleset describes a game ruleset.
+type Ruleset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the ruleset name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Weapons are the choises a player can make in the ruleset.
+	Weapons []*Weapon `protobuf:"bytes,2,rep,name=weapons,proto3" json:"weapons,omitempty"`
+}
+
+func (x *Ruleset) Reset() {
+	*x = Ruleset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ruleset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ruleset) ProtoMessage() {}
+
+func (x *Ruleset) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ruleset.ProtoReflect.Descriptor instead.
+func (*Ruleset) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Ruleset) GetName() string {
 	if x != nil {
-		return x.Choise
+		return x.Name
 	}
-	return EnumChoise_UnknownChoise
+	return ""
 }
 
-func (x *RoundResult) GetStatus() EnumStatus {
+func (x *Ruleset) GetWeapons() []*Weapon {
 	if x != nil {
-		return x.Status
+		return x.Weapons
 	}
-	return EnumStatus_UnknownStatus
+	return nil
 }
 
-// Player decribes a player.
-type Player struct {
+// Weapon describes a choise a player can make in the game ruleset.
+type Weapon struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Id is player ID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Name is play name.
+	// Choise is the choise a player sends to pick the weapon.
+	Choise EnumChoise `protobuf:"varint,1,opt,name=choise,proto3,enum=rps.EnumChoise" json:"choise,omitempty"`
+	// Name is the weapon name.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Beats are the weapons this weapon beats.
+	Beats []EnumChoise `protobuf:"varint,3,rep,packed,name=beats,proto3,enum=rps.EnumChoise" json:"beats,omitempty"`
 }
 
-func (x *Player) Reset() {
-	*x = Player{}
+func (x *Weapon) Reset() {
+	*x = Weapon{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rps_proto_msgTypes[7]
+		mi := &file_rps_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Player) String() string {
+func (x *Weapon) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Player) ProtoMessage() {}
+func (*Weapon) ProtoMessage() {}
 
-func (x *Player) ProtoReflect() protoreflect.Message {
-	mi := &file_rps_proto_msgTypes[7]
+func (x *Weapon) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,58 +1068,70 @@ func (x *Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Player.ProtoReflect.Descriptor instead.
-func (*Player) Descriptor() ([]byte, []int) {
-	return file_rps_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Weapon.ProtoReflect.Descriptor instead.
+func (*Weapon) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Player) GetId() string {
+func (x *Weapon) GetChoise() EnumChoise {
 	if x != nil {
-		return x.Id
+		return x.Choise
 	}
-	return ""
+	return EnumChoise_UnknownChoise
 }
 
-func (x *Player) GetName() string {
+func (x *Weapon) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// GameResult is the current game result of the player.
-type GameResult struct {
+func (x *Weapon) GetBeats() []EnumChoise {
+	if x != nil {
+		return x.Beats
+	}
+	return nil
+}
+
+// Choise is what a player chose.
+type Choise struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Player identifies the player for which the result is for.
-	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// Score is the current score of the player.
-	Score int32 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	// Status is the current game status of the player.
-	Status EnumStatus `protobuf:"varint,3,opt,name=status,proto3,enum=rps.EnumStatus" json:"status,omitempty"`
-	// Rounds is the number of completed rounds in the game.
-	Rounds int32 `protobuf:"varint,4,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// Choise is a player choise.
+	Choise EnumChoise `protobuf:"varint,1,opt,name=choise,proto3,enum=rps.EnumChoise" json:"choise,omitempty"`
+	// Player who made a choise.
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// RoomId is an ID of the room the player plays in.
+	// It is read from the first message of the Play stream only,
+	// empty means the default room.
+	RoomId string `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Commitment commits the player to a choise of the round in a fair play room,
+	// the choise itself is ignored then.
+	Commitment *Commitment `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Reveal discloses the committed choise when the server asks for it in a fair play room.
+	Reveal *Reveal `protobuf:"bytes,5,opt,name=reveal,proto3" json:"reveal,omitempty"`
 }
 
-func (x *GameResult) Reset() {
-	*x = GameResult{}
+func (x *Choise) Reset() {
+	*x = Choise{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rps_proto_msgTypes[8]
+		mi := &file_rps_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameResult) String() string {
+func (x *Choise) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameResult) ProtoMessage() {}
+func (*Choise) ProtoMessage() {}
 
-func (x *GameResult) ProtoReflect() protoreflect.Message {
-	mi := &file_rps_proto_msgTypes[8]
+func (x *Choise) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,162 +1142,3216 @@ func (x *GameResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameResult.ProtoReflect.Descriptor instead.
-func (*GameResult) Descriptor() ([]byte, []int) {
-	return file_rps_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Choise.ProtoReflect.Descriptor instead.
+func (*Choise) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GameResult) GetPlayer() *Player {
+func (x *Choise) GetChoise() EnumChoise {
 	if x != nil {
-		return x.Player
+		return x.Choise
 	}
-	return nil
+	return EnumChoise_UnknownChoise
 }
 
-func (x *GameResult) GetScore() int32 {
+func (x *Choise) GetPlayerId() string {
 	if x != nil {
-		return x.Score
+		return x.PlayerId
 	}
-	return 0
+	return ""
 }
 
-func (x *GameResult) GetStatus() EnumStatus {
+func (x *Choise) GetRoomId() string {
 	if x != nil {
-		return x.Status
+		return x.RoomId
 	}
-	return EnumStatus_UnknownStatus
+	return ""
 }
 
-func (x *GameResult) GetRounds() int32 {
+func (x *Choise) GetCommitment() *Commitment {
 	if x != nil {
-		return x.Rounds
+		return x.Commitment
 	}
-	return 0
+	return nil
 }
 
-var File_rps_proto protoreflect.FileDescriptor
-
-var file_rps_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x72, 0x70, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x72, 0x70, 0x73,
-	0x22, 0x21, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x1e, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x45, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x14, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x06, 0x43, 0x68, 0x6f, 0x69, 0x73,
-	0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x6f, 0x69,
-	0x73, 0x65, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x12, 0x35, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x72, 0x70, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b,
-	0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0b,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
-	0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x12, 0x27, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x6f, 0x69, 0x73,
-	0x65, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x2c, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x88, 0x01, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x23, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73,
-	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x2a, 0x43, 0x0a, 0x0a, 0x45,
-	0x6e, 0x75, 0x6d, 0x43, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x6e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6e, 0x43, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x53, 0x74, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x63, 0x69, 0x73, 0x73,
-	0x6f, 0x72, 0x73, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x61, 0x70, 0x65, 0x72, 0x10, 0x03,
-	0x2a, 0x41, 0x0a, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11,
-	0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0a, 0x0a,
-	0x06, 0x4c, 0x6f, 0x6f, 0x73, 0x65, 0x72, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x72, 0x61,
-	0x77, 0x10, 0x03, 0x32, 0x8f, 0x01, 0x0a, 0x05, 0x47, 0x61, 0x6d, 0x65, 0x72, 0x12, 0x2d, 0x0a,
-	0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x10, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x05,
-	0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x11, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x25,
-	0x0a, 0x04, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x43, 0x68, 0x6f,
-	0x69, 0x73, 0x65, 0x1a, 0x0a, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22,
-	0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x20,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x76, 0x61, 0x75,
-	0x61, 0x2f, 0x72, 0x6f, 0x63, 0x6b, 0x2d, 0x70, 0x61, 0x70, 0x65, 0x72, 0x2d, 0x73, 0x63, 0x69,
-	0x73, 0x73, 0x6f, 0x72, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x72, 0x70, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Choise) GetReveal() *Reveal {
+	if x != nil {
+		return x.Reveal
+	}
+	return nil
 }
 
-var (
-	file_rps_proto_rawDescOnce sync.Once
-	file_rps_proto_rawDescData = file_rps_proto_rawDesc
-)
+// Commitment is a hash of a choise a player makes before any choise of the round is revealed.
+type Commitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_rps_proto_rawDescGZIP() []byte {
-	file_rps_proto_rawDescOnce.Do(func() {
-		file_rps_proto_rawDescData = protoimpl.X.CompressGZIP(file_rps_proto_rawDescData)
-	})
-	return file_rps_proto_rawDescData
+	// Hash is SHA-256 of the decimal number of the choise, a colon and the nonce.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Player who made the commitment, set by the server.
+	Player *Player `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
 }
 
-var file_rps_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rps_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_rps_proto_goTypes = []interface{}{
-	(EnumChoise)(0),       // 0: rps.EnumChoise
-	(EnumStatus)(0),       // 1: rps.EnumStatus
-	(*AuthRequest)(nil),   // 2: rps.AuthRequest
-	(*AuthResponse)(nil),  // 3: rps.AuthResponse
-	(*ReadyRequest)(nil),  // 4: rps.ReadyRequest
-	(*ReadyResponse)(nil), // 5: rps.ReadyResponse
-	(*Choise)(nil),        // 6: rps.Choise
-	(*Score)(nil),         // 7: rps.Score
-	(*RoundResult)(nil),   // 8: rps.RoundResult
-	(*Player)(nil),        // 9: rps.Player
-	(*GameResult)(nil),    // 10: rps.GameResult
+func (x *Commitment) Reset() {
+	*x = Commitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_rps_proto_depIdxs = []int32{
-	0,  // 0: rps.Choise.choise:type_name -> rps.EnumChoise
-	8,  // 1: rps.Score.round_results:type_name -> rps.RoundResult
-	10, // 2: rps.Score.game_results:type_name -> rps.GameResult
-	9,  // 3: rps.RoundResult.player:type_name -> rps.Player
-	0,  // 4: rps.RoundResult.choise:type_name -> rps.EnumChoise
-	1,  // 5: rps.RoundResult.status:type_name -> rps.EnumStatus
-	9,  // 6: rps.GameResult.player:type_name -> rps.Player
-	1,  // 7: rps.GameResult.status:type_name -> rps.EnumStatus
-	2,  // 8: rps.Gamer.Auth:input_type -> rps.AuthRequest
-	4,  // 9: rps.Gamer.Ready:input_type -> rps.ReadyRequest
-	6,  // 10: rps.Gamer.Play:input_type -> rps.Choise
-	3,  // 11: rps.Gamer.Auth:output_type -> rps.AuthResponse
-	5,  // 12: rps.Gamer.Ready:output_type -> rps.ReadyResponse
-	7,  // 13: rps.Gamer.Play:output_type -> rps.Score
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+
+func (x *Commitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_rps_proto_init() }
-func file_rps_proto_init() {
+func (*Commitment) ProtoMessage() {}
+
+func (x *Commitment) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Commitment.ProtoReflect.Descriptor instead.
+func (*Commitment) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Commitment) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Commitment) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+// Reveal discloses the choise a player has committed to.
+type Reveal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Choise is the committed choise.
+	Choise EnumChoise `protobuf:"varint,1,opt,name=choise,proto3,enum=rps.EnumChoise" json:"choise,omitempty"`
+	// Nonce is the random bytes the commitment hash is made with, at least 16 bytes.
+	Nonce []byte `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *Reveal) Reset() {
+	*x = Reveal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reveal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reveal) ProtoMessage() {}
+
+func (x *Reveal) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reveal.ProtoReflect.Descriptor instead.
+func (*Reveal) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Reveal) GetChoise() EnumChoise {
+	if x != nil {
+		return x.Choise
+	}
+	return EnumChoise_UnknownChoise
+}
+
+func (x *Reveal) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+// Score reports the latest round results and the current results of the game.
+type Score struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundResults []*RoundResult `protobuf:"bytes,1,rep,name=round_results,json=roundResults,proto3" json:"round_results,omitempty"`
+	GameResults  []*GameResult  `protobuf:"bytes,2,rep,name=game_results,json=gameResults,proto3" json:"game_results,omitempty"`
+	// Commitments are the commitments of the players of the current round in a fair play room.
+	// A score with commitments and no results asks the players to reveal their choises.
+	Commitments []*Commitment `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Score) GetRoundResults() []*RoundResult {
+	if x != nil {
+		return x.RoundResults
+	}
+	return nil
+}
+
+func (x *Score) GetGameResults() []*GameResult {
+	if x != nil {
+		return x.GameResults
+	}
+	return nil
+}
+
+func (x *Score) GetCommitments() []*Commitment {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+// RoundResult is the latest round result of the player.
+type RoundResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Player identifies the player who made the choise.
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// Choise is the player choise.
+	Choise EnumChoise `protobuf:"varint,2,opt,name=choise,proto3,enum=rps.EnumChoise" json:"choise,omitempty"`
+	// Status is the result of player choise.
+	Status EnumStatus `protobuf:"varint,3,opt,name=status,proto3,enum=rps.EnumStatus" json:"status,omitempty"`
+	// Nonce is the nonce of the revealed choise in a fair play room
+	// to check it against the player's commitment.
+	Nonce []byte `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *RoundResult) Reset() {
+	*x = RoundResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundResult) ProtoMessage() {}
+
+func (x *RoundResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundResult.ProtoReflect.Descriptor instead.
+func (*RoundResult) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RoundResult) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *RoundResult) GetChoise() EnumChoise {
+	if x != nil {
+		return x.Choise
+	}
+	return EnumChoise_UnknownChoise
+}
+
+func (x *RoundResult) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_UnknownStatus
+}
+
+func (x *RoundResult) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+// Player decribes a player.
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is player ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is play name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Rating is the player's skill rating.
+	Rating float64 `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// RatingDeviation is the uncertainty of the rating, 0 if the rating system does not track it.
+	RatingDeviation float64 `protobuf:"fixed64,4,opt,name=rating_deviation,json=ratingDeviation,proto3" json:"rating_deviation,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Player) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Player) GetRatingDeviation() float64 {
+	if x != nil {
+		return x.RatingDeviation
+	}
+	return 0
+}
+
+// GameResult is the current game result of the player.
+type GameResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Player identifies the player for which the result is for.
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// Score is the current score of the player.
+	Score int32 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// Status is the current game status of the player.
+	Status EnumStatus `protobuf:"varint,3,opt,name=status,proto3,enum=rps.EnumStatus" json:"status,omitempty"`
+	// Rounds is the number of completed rounds in the game.
+	Rounds int32 `protobuf:"varint,4,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// Forfeited means the player has left the game or has not come back to it in time,
+	// the player is a looser of the game whatever the score is.
+	Forfeited bool `protobuf:"varint,5,opt,name=forfeited,proto3" json:"forfeited,omitempty"`
+}
+
+func (x *GameResult) Reset() {
+	*x = GameResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameResult) ProtoMessage() {}
+
+func (x *GameResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameResult.ProtoReflect.Descriptor instead.
+func (*GameResult) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GameResult) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *GameResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GameResult) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_UnknownStatus
+}
+
+func (x *GameResult) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *GameResult) GetForfeited() bool {
+	if x != nil {
+		return x.Forfeited
+	}
+	return false
+}
+
+// CreateRoomRequest is a request to create a game room.
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PlayerId is an ID of the player who creates the room.
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Name is the room name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Settings are the game settings of the room, unset ones are taken from the server defaults.
+	Settings *RoomSettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateRoomRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetSettings() *RoomSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// ListRoomsRequest is a request of the game rooms.
+type ListRoomsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsRequest) ProtoMessage() {}
+
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{18}
+}
+
+// ListRoomsResponse lists the game rooms.
+type ListRoomsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rooms are the game rooms.
+	Rooms []*Room `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+}
+
+func (x *ListRoomsResponse) Reset() {
+	*x = ListRoomsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsResponse) ProtoMessage() {}
+
+func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListRoomsResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+// JoinRoomRequest is a request to join a game room.
+type JoinRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PlayerId is an ID of the player.
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// RoomId is an ID of the room.
+	RoomId string `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequest) ProtoMessage() {}
+
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *JoinRoomRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+// LeaveRoomRequest is a request to leave a game room.
+type LeaveRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PlayerId is an ID of the player.
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// RoomId is an ID of the room.
+	RoomId string `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *LeaveRoomRequest) Reset() {
+	*x = LeaveRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRoomRequest) ProtoMessage() {}
+
+func (x *LeaveRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRoomRequest.ProtoReflect.Descriptor instead.
+func (*LeaveRoomRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LeaveRoomRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *LeaveRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+// LeaveRoomResponse is a response to the leave room request.
+type LeaveRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveRoomResponse) Reset() {
+	*x = LeaveRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRoomResponse) ProtoMessage() {}
+
+func (x *LeaveRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRoomResponse.ProtoReflect.Descriptor instead.
+func (*LeaveRoomResponse) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{22}
+}
+
+// Room describes a game room.
+type Room struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the room ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is the room name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Settings are the game settings of the room.
+	Settings *RoomSettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Players are the players who joined the room.
+	Players []*Player `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	// State is the game state of the room.
+	State EnumRoomState `protobuf:"varint,5,opt,name=state,proto3,enum=rps.EnumRoomState" json:"state,omitempty"`
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Room) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Room) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Room) GetSettings() *RoomSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Room) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Room) GetState() EnumRoomState {
+	if x != nil {
+		return x.State
+	}
+	return EnumRoomState_UnknownRoomState
+}
+
+// RoomSettings are the game settings of a room.
+type RoomSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ruleset is the name of the game ruleset.
+	Ruleset string `protobuf:"bytes,1,opt,name=ruleset,proto3" json:"ruleset,omitempty"`
+	// Policy is the name of the multi-player round policy.
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// ChoiseTimeoutSeconds is a timeout for player's choise in seconds.
+	ChoiseTimeoutSeconds int32 `protobuf:"varint,3,opt,name=choise_timeout_seconds,json=choiseTimeoutSeconds,proto3" json:"choise_timeout_seconds,omitempty"`
+	// MinPlayers is the number of ready players needed to start the game.
+	MinPlayers int32 `protobuf:"varint,4,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	// MaxPlayers is the maximum number of players in the room, 0 means unlimited.
+	MaxPlayers int32 `protobuf:"varint,5,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	// BestOf ends the game after the number of rounds or as soon as the leader cannot be caught up.
+	BestOf int32 `protobuf:"varint,6,opt,name=best_of,json=bestOf,proto3" json:"best_of,omitempty"`
+	// FirstTo ends the game when a player gets the number of points.
+	FirstTo int32 `protobuf:"varint,7,opt,name=first_to,json=firstTo,proto3" json:"first_to,omitempty"`
+	// Rounds ends the game after the number of rounds.
+	Rounds int32 `protobuf:"varint,8,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// SuddenDeath makes the game go on until the tie of the leaders is broken.
+	SuddenDeath bool `protobuf:"varint,9,opt,name=sudden_death,json=suddenDeath,proto3" json:"sudden_death,omitempty"`
+	// FairPlay makes the players commit to their choises before any choise is revealed,
+	// a player whose reveal does not match the commitment forfeits the round.
+	FairPlay bool `protobuf:"varint,10,opt,name=fair_play,json=fairPlay,proto3" json:"fair_play,omitempty"`
+}
+
+func (x *RoomSettings) Reset() {
+	*x = RoomSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomSettings) ProtoMessage() {}
+
+func (x *RoomSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomSettings.ProtoReflect.Descriptor instead.
+func (*RoomSettings) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RoomSettings) GetRuleset() string {
+	if x != nil {
+		return x.Ruleset
+	}
+	return ""
+}
+
+func (x *RoomSettings) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *RoomSettings) GetChoiseTimeoutSeconds() int32 {
+	if x != nil {
+		return x.ChoiseTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *RoomSettings) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *RoomSettings) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *RoomSettings) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
+func (x *RoomSettings) GetFirstTo() int32 {
+	if x != nil {
+		return x.FirstTo
+	}
+	return 0
+}
+
+func (x *RoomSettings) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *RoomSettings) GetSuddenDeath() bool {
+	if x != nil {
+		return x.SuddenDeath
+	}
+	return false
+}
+
+func (x *RoomSettings) GetFairPlay() bool {
+	if x != nil {
+		return x.FairPlay
+	}
+	return false
+}
+
+// FindMatchRequest is a request to find a match for a player.
+type FindMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PlayerId is an ID of the player.
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// MatchSize is the number of players in the match, 2 if it is not set.
+	MatchSize int32 `protobuf:"varint,2,opt,name=match_size,json=matchSize,proto3" json:"match_size,omitempty"`
+	// Ruleset is the name of the game ruleset, the server default if it is not set.
+	Ruleset string `protobuf:"bytes,3,opt,name=ruleset,proto3" json:"ruleset,omitempty"`
+}
+
+func (x *FindMatchRequest) Reset() {
+	*x = FindMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMatchRequest) ProtoMessage() {}
+
+func (x *FindMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMatchRequest.ProtoReflect.Descriptor instead.
+func (*FindMatchRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FindMatchRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *FindMatchRequest) GetMatchSize() int32 {
+	if x != nil {
+		return x.MatchSize
+	}
+	return 0
+}
+
+func (x *FindMatchRequest) GetRuleset() string {
+	if x != nil {
+		return x.Ruleset
+	}
+	return ""
+}
+
+// MatchUpdate reports the matchmaking progress.
+type MatchUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Position is the player's position in the queue among the players waiting for the same match.
+	Position int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	// Room is the game room of the found match, it is set in the last update only.
+	Room *Room `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *MatchUpdate) Reset() {
+	*x = MatchUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchUpdate) ProtoMessage() {}
+
+func (x *MatchUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchUpdate.ProtoReflect.Descriptor instead.
+func (*MatchUpdate) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MatchUpdate) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MatchUpdate) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+// GetPlayerStatsRequest is a request of a player's stats.
+type GetPlayerStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PlayerId is an ID of the player.
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *GetPlayerStatsRequest) Reset() {
+	*x = GetPlayerStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatsRequest) ProtoMessage() {}
+
+func (x *GetPlayerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetPlayerStatsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// PlayerStats are the rating and the game history of a player.
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Player is the player with the current rating.
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// Games is the number of completed games.
+	Games int32 `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	// Wins is the number of won games.
+	Wins int32 `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	// Losses is the number of lost games.
+	Losses int32 `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	// Draws is the number of drawn games.
+	Draws int32 `protobuf:"varint,5,opt,name=draws,proto3" json:"draws,omitempty"`
+	// History are the rating changes by the completed games, the latest first.
+	History []*RatingChange `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PlayerStats) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetHistory() []*RatingChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// RatingChange is a player's rating change by a completed game.
+type RatingChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RoomId is an ID of the game room, the server numbers the rooms anew when it restarts.
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Ruleset is the name of the game ruleset.
+	Ruleset string `protobuf:"bytes,2,opt,name=ruleset,proto3" json:"ruleset,omitempty"`
+	// Finished is the time the game was over.
+	Finished *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=finished,proto3" json:"finished,omitempty"`
+	// Score is the player's final score.
+	Score int32 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	// Status is the player's final game status.
+	Status EnumStatus `protobuf:"varint,5,opt,name=status,proto3,enum=rps.EnumStatus" json:"status,omitempty"`
+	// RatingBefore is the player's rating before the game.
+	RatingBefore float64 `protobuf:"fixed64,6,opt,name=rating_before,json=ratingBefore,proto3" json:"rating_before,omitempty"`
+	// RatingAfter is the player's rating after the game.
+	RatingAfter float64 `protobuf:"fixed64,7,opt,name=rating_after,json=ratingAfter,proto3" json:"rating_after,omitempty"`
+	// GameId is a unique ID of the game.
+	GameId string `protobuf:"bytes,8,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *RatingChange) Reset() {
+	*x = RatingChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RatingChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingChange) ProtoMessage() {}
+
+func (x *RatingChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingChange.ProtoReflect.Descriptor instead.
+func (*RatingChange) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RatingChange) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RatingChange) GetRuleset() string {
+	if x != nil {
+		return x.Ruleset
+	}
+	return ""
+}
+
+func (x *RatingChange) GetFinished() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Finished
+	}
+	return nil
+}
+
+func (x *RatingChange) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RatingChange) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_UnknownStatus
+}
+
+func (x *RatingChange) GetRatingBefore() float64 {
+	if x != nil {
+		return x.RatingBefore
+	}
+	return 0
+}
+
+func (x *RatingChange) GetRatingAfter() float64 {
+	if x != nil {
+		return x.RatingAfter
+	}
+	return 0
+}
+
+func (x *RatingChange) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// LeaderboardRequest is a leaderboard page request.
+type LeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ruleset limits the games to the ruleset, all the games if it is not set.
+	Ruleset string `protobuf:"bytes,1,opt,name=ruleset,proto3" json:"ruleset,omitempty"`
+	// Period limits the games to the time window.
+	Period EnumPeriod `protobuf:"varint,2,opt,name=period,proto3,enum=rps.EnumPeriod" json:"period,omitempty"`
+	// Sort is the order of the players.
+	Sort EnumLeaderboardSort `protobuf:"varint,3,opt,name=sort,proto3,enum=rps.EnumLeaderboardSort" json:"sort,omitempty"`
+	// PageSize is the maximum number of the players in the page, the server picks it if it is not set.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// PageToken is the next_page_token of the previous page, the first page if it is not set.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LeaderboardRequest) GetRuleset() string {
+	if x != nil {
+		return x.Ruleset
+	}
+	return ""
+}
+
+func (x *LeaderboardRequest) GetPeriod() EnumPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return EnumPeriod_AllTime
+}
+
+func (x *LeaderboardRequest) GetSort() EnumLeaderboardSort {
+	if x != nil {
+		return x.Sort
+	}
+	return EnumLeaderboardSort_ByRating
+}
+
+func (x *LeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// LeaderboardResponse is a leaderboard page.
+type LeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entries are the ranked players of the page.
+	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// NextPageToken requests the next page, it is not set on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total is the number of the ranked players in all the pages.
+	Total int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *LeaderboardResponse) Reset() {
+	*x = LeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardResponse) ProtoMessage() {}
+
+func (x *LeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*LeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LeaderboardResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *LeaderboardResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// LeaderboardEntry is a ranked player.
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rank is the player's 1-based rank.
+	Rank int32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Name is the player name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Rating is the player's current skill rating.
+	Rating float64 `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Games is the number of completed games.
+	Games int32 `protobuf:"varint,4,opt,name=games,proto3" json:"games,omitempty"`
+	// Wins is the number of won games.
+	Wins int32 `protobuf:"varint,5,opt,name=wins,proto3" json:"wins,omitempty"`
+	// Losses is the number of lost games.
+	Losses int32 `protobuf:"varint,6,opt,name=losses,proto3" json:"losses,omitempty"`
+	// Draws is the number of drawn games.
+	Draws int32 `protobuf:"varint,7,opt,name=draws,proto3" json:"draws,omitempty"`
+	// WinRate is the share of won games from 0 to 1.
+	WinRate float64 `protobuf:"fixed64,8,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	// PlayerId is an ID of the player.
+	PlayerId string `protobuf:"bytes,9,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// CreateTournamentRequest is a request to create a tournament.
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is a tournament name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Format is the name of the tournament format, round-robin if it is not set.
+	// Available formats are round-robin, single-elimination, double-elimination and swiss.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Settings are the settings of the tournament matches, unset ones are taken from the server defaults.
+	// A match is always played by two players and lasts best of 3 rounds if no game length is set.
+	Settings *RoomSettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	// PlayerIds are the IDs of the players registered right away.
+	// Only the creating player can be registered this way, the other players join the tournament themselves.
+	PlayerIds []string `protobuf:"bytes,4,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	// ReadyTimeoutSeconds is the time for the players of a match to get ready,
+	// a player who is not ready in time forfeits the match.
+	// The server picks it if it is not set.
+	ReadyTimeoutSeconds int32 `protobuf:"varint,5,opt,name=ready_timeout_seconds,json=readyTimeoutSeconds,proto3" json:"ready_timeout_seconds,omitempty"`
+	// Rounds is the number of rounds of a Swiss tournament,
+	// log2 of the number of players rounded up if it is not set.
+	Rounds int32 `protobuf:"varint,6,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// PlayerId is an ID of the player who creates the tournament.
+	PlayerId string `protobuf:"bytes,7,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetSettings() *RoomSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetReadyTimeoutSeconds() int32 {
+	if x != nil {
+		return x.ReadyTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// JoinTournamentRequest is a request to register a player in a tournament.
+type JoinTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PlayerId is an ID of a player.
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// TournamentId is an ID of the tournament.
+	TournamentId string `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *JoinTournamentRequest) Reset() {
+	*x = JoinTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTournamentRequest) ProtoMessage() {}
+
+func (x *JoinTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTournamentRequest.ProtoReflect.Descriptor instead.
+func (*JoinTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JoinTournamentRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *JoinTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+// StartTournamentRequest is a request to start a tournament.
+type StartTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TournamentId is an ID of the tournament.
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// PlayerId is an ID of the player who starts the tournament.
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *StartTournamentRequest) Reset() {
+	*x = StartTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTournamentRequest) ProtoMessage() {}
+
+func (x *StartTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTournamentRequest.ProtoReflect.Descriptor instead.
+func (*StartTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *StartTournamentRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// GetTournamentRequest is a request of a tournament.
+type GetTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TournamentId is an ID of the tournament.
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *GetTournamentRequest) Reset() {
+	*x = GetTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentRequest) ProtoMessage() {}
+
+func (x *GetTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+// ListTournamentsRequest is a request to list the tournaments.
+type ListTournamentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTournamentsRequest) Reset() {
+	*x = ListTournamentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTournamentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTournamentsRequest) ProtoMessage() {}
+
+func (x *ListTournamentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTournamentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTournamentsRequest) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{37}
+}
+
+// ListTournamentsResponse lists the tournaments.
+type ListTournamentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tournaments []*Tournament `protobuf:"bytes,1,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+}
+
+func (x *ListTournamentsResponse) Reset() {
+	*x = ListTournamentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTournamentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTournamentsResponse) ProtoMessage() {}
+
+func (x *ListTournamentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTournamentsResponse.ProtoReflect.Descriptor instead.
+func (*ListTournamentsResponse) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListTournamentsResponse) GetTournaments() []*Tournament {
+	if x != nil {
+		return x.Tournaments
+	}
+	return nil
+}
+
+// Tournament describes a tournament.
+type Tournament struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is a tournament ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is a tournament name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Format is the name of the tournament format.
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Settings are the settings of the tournament matches.
+	Settings *RoomSettings `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+	// State is the tournament state.
+	State EnumTournamentState `protobuf:"varint,5,opt,name=state,proto3,enum=rps.EnumTournamentState" json:"state,omitempty"`
+	// Players are the registered players, ordered by seed once the tournament is started.
+	Players []*Player `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"`
+	// Matches are the scheduled matches.
+	Matches []*TournamentMatch `protobuf:"bytes,7,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Standings rank the players by the finished matches.
+	Standings []*TournamentStanding `protobuf:"bytes,8,rep,name=standings,proto3" json:"standings,omitempty"`
+	// ReadyTimeoutSeconds is the time for the players of a match to get ready.
+	ReadyTimeoutSeconds int32 `protobuf:"varint,9,opt,name=ready_timeout_seconds,json=readyTimeoutSeconds,proto3" json:"ready_timeout_seconds,omitempty"`
+	// Owner is the player who created the tournament.
+	Owner *Player `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Tournament) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Tournament) GetSettings() *RoomSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Tournament) GetState() EnumTournamentState {
+	if x != nil {
+		return x.State
+	}
+	return EnumTournamentState_UnknownTournamentState
+}
+
+func (x *Tournament) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Tournament) GetMatches() []*TournamentMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *Tournament) GetStandings() []*TournamentStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *Tournament) GetReadyTimeoutSeconds() int32 {
+	if x != nil {
+		return x.ReadyTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Tournament) GetOwner() *Player {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// TournamentMatch is a match of two players in a tournament.
+type TournamentMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the match number in the tournament.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Round is the tournament round of the match.
+	Round int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// Players are the players of the match, a player with no ID is a bye or is not known yet.
+	Players []*Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	// RoomId is an ID of the game room of the match, it is set while the match is played.
+	// The players get ready and play in the room like in any other one.
+	RoomId string `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// State is the match state.
+	State EnumRoomState `protobuf:"varint,5,opt,name=state,proto3,enum=rps.EnumRoomState" json:"state,omitempty"`
+	// Scores are the players' final scores in the order of the players.
+	Scores []int32 `protobuf:"varint,6,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	// WinnerId is an ID of the winner, it is empty if no one won.
+	WinnerId string `protobuf:"bytes,7,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	// Forfeit means the match was not played, the winner, if any, won by default.
+	Forfeit bool `protobuf:"varint,8,opt,name=forfeit,proto3" json:"forfeit,omitempty"`
+	// Bracket is the bracket of an elimination tournament the match belongs to.
+	Bracket EnumBracket `protobuf:"varint,9,opt,name=bracket,proto3,enum=rps.EnumBracket" json:"bracket,omitempty"`
+	// Sources tell where the players of the match come from in the order of the players.
+	Sources []*MatchSource `protobuf:"bytes,10,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *TournamentMatch) Reset() {
+	*x = TournamentMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentMatch) ProtoMessage() {}
+
+func (x *TournamentMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentMatch.ProtoReflect.Descriptor instead.
+func (*TournamentMatch) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TournamentMatch) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetState() EnumRoomState {
+	if x != nil {
+		return x.State
+	}
+	return EnumRoomState_UnknownRoomState
+}
+
+func (x *TournamentMatch) GetScores() []int32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetForfeit() bool {
+	if x != nil {
+		return x.Forfeit
+	}
+	return false
+}
+
+func (x *TournamentMatch) GetBracket() EnumBracket {
+	if x != nil {
+		return x.Bracket
+	}
+	return EnumBracket_NoBracket
+}
+
+func (x *TournamentMatch) GetSources() []*MatchSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+// MatchSource is a match which decides a player of another match.
+type MatchSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MatchId is the deciding match, 0 means the player is known at the start.
+	MatchId int32 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// Loser means the loser of the deciding match plays, otherwise the winner does.
+	Loser bool `protobuf:"varint,2,opt,name=loser,proto3" json:"loser,omitempty"`
+}
+
+func (x *MatchSource) Reset() {
+	*x = MatchSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchSource) ProtoMessage() {}
+
+func (x *MatchSource) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchSource.ProtoReflect.Descriptor instead.
+func (*MatchSource) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MatchSource) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *MatchSource) GetLoser() bool {
+	if x != nil {
+		return x.Loser
+	}
+	return false
+}
+
+// Bracket is a tree of the matches of a tournament.
+type Bracket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TournamentId is an ID of the tournament.
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// Roots are the matches whose winners do not play any other match, the final ones last.
+	Roots []*BracketNode `protobuf:"bytes,2,rep,name=roots,proto3" json:"roots,omitempty"`
+}
+
+func (x *Bracket) Reset() {
+	*x = Bracket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bracket) ProtoMessage() {}
+
+func (x *Bracket) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bracket.ProtoReflect.Descriptor instead.
+func (*Bracket) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Bracket) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *Bracket) GetRoots() []*BracketNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+// BracketNode is a match in the tree of the matches of a tournament.
+type BracketNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Match is the match.
+	Match *TournamentMatch `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Children are the matches whose winners play the match.
+	Children []*BracketNode `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *BracketNode) Reset() {
+	*x = BracketNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BracketNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketNode) ProtoMessage() {}
+
+func (x *BracketNode) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketNode.ProtoReflect.Descriptor instead.
+func (*BracketNode) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BracketNode) GetMatch() *TournamentMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *BracketNode) GetChildren() []*BracketNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// TournamentStanding is a player's tournament result.
+type TournamentStanding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rank is the player's 1-based rank.
+	Rank int32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Player is the player.
+	Player *Player `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	// Played is the number of finished matches.
+	Played int32 `protobuf:"varint,3,opt,name=played,proto3" json:"played,omitempty"`
+	// Wins is the number of won matches.
+	Wins int32 `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	// Draws is the number of drawn matches.
+	Draws int32 `protobuf:"varint,5,opt,name=draws,proto3" json:"draws,omitempty"`
+	// Losses is the number of lost matches.
+	Losses int32 `protobuf:"varint,6,opt,name=losses,proto3" json:"losses,omitempty"`
+	// Points are the tournament points: 1 for a win and 0.5 for a draw.
+	Points float64 `protobuf:"fixed64,7,opt,name=points,proto3" json:"points,omitempty"`
+	// Differential is the number of won rounds minus the number of lost rounds.
+	Differential int32 `protobuf:"varint,8,opt,name=differential,proto3" json:"differential,omitempty"`
+	// Buchholz is the sum of the points of the opponents, it is set in Swiss tournaments.
+	Buchholz float64 `protobuf:"fixed64,9,opt,name=buchholz,proto3" json:"buchholz,omitempty"`
+}
+
+func (x *TournamentStanding) Reset() {
+	*x = TournamentStanding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rps_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStanding) ProtoMessage() {}
+
+func (x *TournamentStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_rps_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStanding.ProtoReflect.Descriptor instead.
+func (*TournamentStanding) Descriptor() ([]byte, []int) {
+	return file_rps_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TournamentStanding) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *TournamentStanding) GetPlayed() int32 {
+	if x != nil {
+		return x.Played
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetDifferential() int32 {
+	if x != nil {
+		return x.Differential
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetBuchholz() float64 {
+	if x != nil {
+		return x.Buchholz
+	}
+	return 0
+}
+
+var File_rps_proto protoreflect.FileDescriptor
+
+var file_rps_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x72, 0x70, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x72, 0x70, 0x73,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xdc, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x26, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7d, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x44, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x68,
+	0x6f, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x63, 0x68, 0x6f, 0x69,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x65,
+	0x61, 0x70, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x73, 0x2e, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x52, 0x07, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x69, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x22, 0x11,
+	0x0a, 0x0f, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3c, 0x0a, 0x10, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x73, 0x22,
+	0x44, 0x0a, 0x07, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x07, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x52, 0x07, 0x77, 0x65,
+	0x61, 0x70, 0x6f, 0x6e, 0x73, 0x22, 0x6c, 0x0a, 0x06, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x6f, 0x69, 0x73, 0x65,
+	0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05,
+	0x62, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x70,
+	0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x52, 0x05, 0x62, 0x65,
+	0x61, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x06, 0x43, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x52,
+	0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x2f, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x52, 0x06, 0x72, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x22, 0x45, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x06, 0x52, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x43,
+	0x68, 0x6f, 0x69, 0x73, 0x65, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x0a,
+	0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x67, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x72, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x0b,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x6f, 0x69, 0x73,
+	0x65, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x6f, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74,
+	0x65, 0x64, 0x22, 0x73, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d,
+	0x73, 0x22, 0x47, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x10, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x04, 0x52, 0x6f,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x70,
+	0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xc4, 0x02, 0x0a, 0x0c, 0x52, 0x6f, 0x6f, 0x6d, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x68, 0x6f,
+	0x69, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x63, 0x68, 0x6f, 0x69, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x75, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x75, 0x64, 0x64, 0x65, 0x6e, 0x44, 0x65, 0x61, 0x74, 0x68,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x69, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x22, 0x68, 0x0a,
+	0x10, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x22, 0x48, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x6d, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64,
+	0x72, 0x61, 0x77, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x22, 0x99, 0x02, 0x0a, 0x0c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xc1, 0x01,
+	0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x27,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6f, 0x72, 0x74, 0x52,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xe2, 0x01, 0x0a, 0x10, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfc, 0x01,
+	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x13, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x15,
+	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x8c, 0x03, 0x0a, 0x0a, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72,
+	0x70, 0x73, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x13, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xc8, 0x02, 0x0a, 0x0f, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x62, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x70,
+	0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x22, 0x3e, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x73,
+	0x65, 0x72, 0x22, 0x56, 0x0a, 0x07, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x0b, 0x42, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x42, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x22, 0xff, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x69, 0x66,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x63,
+	0x68, 0x68, 0x6f, 0x6c, 0x7a, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x75, 0x63,
+	0x68, 0x68, 0x6f, 0x6c, 0x7a, 0x2a, 0xdd, 0x01, 0x0a, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x43, 0x68,
+	0x6f, 0x69, 0x73, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x43,
+	0x68, 0x6f, 0x69, 0x73, 0x65, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x6e, 0x65,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x63, 0x69, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x10, 0x02,
+	0x12, 0x09, 0x0a, 0x05, 0x50, 0x61, 0x70, 0x65, 0x72, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4c,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x70, 0x6f, 0x63, 0x6b,
+	0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x69, 0x72, 0x65, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05,
+	0x53, 0x6e, 0x61, 0x6b, 0x65, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x75, 0x6d, 0x61, 0x6e,
+	0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x72, 0x65, 0x65, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04,
+	0x57, 0x6f, 0x6c, 0x66, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x70, 0x6f, 0x6e, 0x67, 0x65,
+	0x10, 0x0b, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x69, 0x72, 0x10, 0x0c, 0x12, 0x09, 0x0a, 0x05, 0x57,
+	0x61, 0x74, 0x65, 0x72, 0x10, 0x0d, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x72, 0x61, 0x67, 0x6f, 0x6e,
+	0x10, 0x0e, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x76, 0x69, 0x6c, 0x10, 0x0f, 0x12, 0x0d, 0x0a,
+	0x09, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x10, 0x12, 0x07, 0x0a, 0x03,
+	0x47, 0x75, 0x6e, 0x10, 0x11, 0x2a, 0x41, 0x0a, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x73, 0x65, 0x72, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x44, 0x72, 0x61, 0x77, 0x10, 0x03, 0x2a, 0x49, 0x0a, 0x0d, 0x45, 0x6e, 0x75, 0x6d,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x76, 0x65,
+	0x72, 0x10, 0x03, 0x2a, 0x30, 0x0a, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x65, 0x65,
+	0x6b, 0x6c, 0x79, 0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x13, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x0c, 0x0a, 0x08,
+	0x42, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x79,
+	0x57, 0x69, 0x6e, 0x73, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x79, 0x57, 0x69, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x79, 0x47, 0x61, 0x6d, 0x65, 0x73,
+	0x10, 0x03, 0x2a, 0x53, 0x0a, 0x0b, 0x45, 0x6e, 0x75, 0x6d, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x6f, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x42, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x42, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x72, 0x61, 0x6e, 0x64,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x10, 0x03, 0x2a, 0x5d, 0x0a, 0x13, 0x45, 0x6e, 0x75, 0x6d, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x16, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x10, 0x03, 0x32, 0xb7, 0x08, 0x0a, 0x05, 0x47, 0x61, 0x6d, 0x65, 0x72,
+	0x12, 0x2d, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x10, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x15, 0x2e, 0x72,
+	0x70, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a,
+	0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x11, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x70, 0x73, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x25, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x0b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x43, 0x68,
+	0x6f, 0x69, 0x73, 0x65, 0x1a, 0x0a, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x31, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12,
+	0x16, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x2d, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x14,
+	0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x15,
+	0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x38, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x72,
+	0x70, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x70,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x43, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0c, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00,
+	0x42, 0x36, 0x5a, 0x34, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x76, 0x61, 0x75, 0x61, 0x2f, 0x72, 0x6f, 0x63,
+	0x6b, 0x2d, 0x70, 0x61, 0x70, 0x65, 0x72, 0x2d, 0x73, 0x63, 0x69, 0x73, 0x73, 0x6f, 0x72, 0x73,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x72, 0x70, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rps_proto_rawDescOnce sync.Once
+	file_rps_proto_rawDescData = file_rps_proto_rawDesc
+)
+
+func file_rps_proto_rawDescGZIP() []byte {
+	file_rps_proto_rawDescOnce.Do(func() {
+		file_rps_proto_rawDescData = protoimpl.X.CompressGZIP(file_rps_proto_rawDescData)
+	})
+	return file_rps_proto_rawDescData
+}
+
+var file_rps_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_rps_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_rps_proto_goTypes = []interface{}{
+	(EnumChoise)(0),                 // 0: rps.EnumChoise
+	(EnumStatus)(0),                 // 1: rps.EnumStatus
+	(EnumRoomState)(0),              // 2: rps.EnumRoomState
+	(EnumPeriod)(0),                 // 3: rps.EnumPeriod
+	(EnumLeaderboardSort)(0),        // 4: rps.EnumLeaderboardSort
+	(EnumBracket)(0),                // 5: rps.EnumBracket
+	(EnumTournamentState)(0),        // 6: rps.EnumTournamentState
+	(*AuthRequest)(nil),             // 7: rps.AuthRequest
+	(*ChallengeRequest)(nil),        // 8: rps.ChallengeRequest
+	(*ChallengeResponse)(nil),       // 9: rps.ChallengeResponse
+	(*AuthResponse)(nil),            // 10: rps.AuthResponse
+	(*ReadyRequest)(nil),            // 11: rps.ReadyRequest
+	(*ReadyResponse)(nil),           // 12: rps.ReadyResponse
+	(*RulesetsRequest)(nil),         // 13: rps.RulesetsRequest
+	(*RulesetsResponse)(nil),        // 14: rps.RulesetsResponse
+	(*Ruleset)(nil),                 // 15: rps.Ruleset
+	(*Weapon)(nil),                  // 16: rps.Weapon
+	(*Choise)(nil),                  // 17: rps.Choise
+	(*Commitment)(nil),              // 18: rps.Commitment
+	(*Reveal)(nil),                  // 19: rps.Reveal
+	(*Score)(nil),                   // 20: rps.Score
+	(*RoundResult)(nil),             // 21: rps.RoundResult
+	(*Player)(nil),                  // 22: rps.Player
+	(*GameResult)(nil),              // 23: rps.GameResult
+	(*CreateRoomRequest)(nil),       // 24: rps.CreateRoomRequest
+	(*ListRoomsRequest)(nil),        // 25: rps.ListRoomsRequest
+	(*ListRoomsResponse)(nil),       // 26: rps.ListRoomsResponse
+	(*JoinRoomRequest)(nil),         // 27: rps.JoinRoomRequest
+	(*LeaveRoomRequest)(nil),        // 28: rps.LeaveRoomRequest
+	(*LeaveRoomResponse)(nil),       // 29: rps.LeaveRoomResponse
+	(*Room)(nil),                    // 30: rps.Room
+	(*RoomSettings)(nil),            // 31: rps.RoomSettings
+	(*FindMatchRequest)(nil),        // 32: rps.FindMatchRequest
+	(*MatchUpdate)(nil),             // 33: rps.MatchUpdate
+	(*GetPlayerStatsRequest)(nil),   // 34: rps.GetPlayerStatsRequest
+	(*PlayerStats)(nil),             // 35: rps.PlayerStats
+	(*RatingChange)(nil),            // 36: rps.RatingChange
+	(*LeaderboardRequest)(nil),      // 37: rps.LeaderboardRequest
+	(*LeaderboardResponse)(nil),     // 38: rps.LeaderboardResponse
+	(*LeaderboardEntry)(nil),        // 39: rps.LeaderboardEntry
+	(*CreateTournamentRequest)(nil), // 40: rps.CreateTournamentRequest
+	(*JoinTournamentRequest)(nil),   // 41: rps.JoinTournamentRequest
+	(*StartTournamentRequest)(nil),  // 42: rps.StartTournamentRequest
+	(*GetTournamentRequest)(nil),    // 43: rps.GetTournamentRequest
+	(*ListTournamentsRequest)(nil),  // 44: rps.ListTournamentsRequest
+	(*ListTournamentsResponse)(nil), // 45: rps.ListTournamentsResponse
+	(*Tournament)(nil),              // 46: rps.Tournament
+	(*TournamentMatch)(nil),         // 47: rps.TournamentMatch
+	(*MatchSource)(nil),             // 48: rps.MatchSource
+	(*Bracket)(nil),                 // 49: rps.Bracket
+	(*BracketNode)(nil),             // 50: rps.BracketNode
+	(*TournamentStanding)(nil),      // 51: rps.TournamentStanding
+	(*timestamppb.Timestamp)(nil),   // 52: google.protobuf.Timestamp
+}
+var file_rps_proto_depIdxs = []int32{
+	22, // 0: rps.AuthResponse.player:type_name -> rps.Player
+	16, // 1: rps.ReadyResponse.weapons:type_name -> rps.Weapon
+	15, // 2: rps.RulesetsResponse.rulesets:type_name -> rps.Ruleset
+	16, // 3: rps.Ruleset.weapons:type_name -> rps.Weapon
+	0,  // 4: rps.Weapon.choise:type_name -> rps.EnumChoise
+	0,  // 5: rps.Weapon.beats:type_name -> rps.EnumChoise
+	0,  // 6: rps.Choise.choise:type_name -> rps.EnumChoise
+	18, // 7: rps.Choise.commitment:type_name -> rps.Commitment
+	19, // 8: rps.Choise.reveal:type_name -> rps.Reveal
+	22, // 9: rps.Commitment.player:type_name -> rps.Player
+	0,  // 10: rps.Reveal.choise:type_name -> rps.EnumChoise
+	21, // 11: rps.Score.round_results:type_name -> rps.RoundResult
+	23, // 12: rps.Score.game_results:type_name -> rps.GameResult
+	18, // 13: rps.Score.commitments:type_name -> rps.Commitment
+	22, // 14: rps.RoundResult.player:type_name -> rps.Player
+	0,  // 15: rps.RoundResult.choise:type_name -> rps.EnumChoise
+	1,  // 16: rps.RoundResult.status:type_name -> rps.EnumStatus
+	22, // 17: rps.GameResult.player:type_name -> rps.Player
+	1,  // 18: rps.GameResult.status:type_name -> rps.EnumStatus
+	31, // 19: rps.CreateRoomRequest.settings:type_name -> rps.RoomSettings
+	30, // 20: rps.ListRoomsResponse.rooms:type_name -> rps.Room
+	31, // 21: rps.Room.settings:type_name -> rps.RoomSettings
+	22, // 22: rps.Room.players:type_name -> rps.Player
+	2,  // 23: rps.Room.state:type_name -> rps.EnumRoomState
+	30, // 24: rps.MatchUpdate.room:type_name -> rps.Room
+	22, // 25: rps.PlayerStats.player:type_name -> rps.Player
+	36, // 26: rps.PlayerStats.history:type_name -> rps.RatingChange
+	52, // 27: rps.RatingChange.finished:type_name -> google.protobuf.Timestamp
+	1,  // 28: rps.RatingChange.status:type_name -> rps.EnumStatus
+	3,  // 29: rps.LeaderboardRequest.period:type_name -> rps.EnumPeriod
+	4,  // 30: rps.LeaderboardRequest.sort:type_name -> rps.EnumLeaderboardSort
+	39, // 31: rps.LeaderboardResponse.entries:type_name -> rps.LeaderboardEntry
+	31, // 32: rps.CreateTournamentRequest.settings:type_name -> rps.RoomSettings
+	46, // 33: rps.ListTournamentsResponse.tournaments:type_name -> rps.Tournament
+	31, // 34: rps.Tournament.settings:type_name -> rps.RoomSettings
+	6,  // 35: rps.Tournament.state:type_name -> rps.EnumTournamentState
+	22, // 36: rps.Tournament.players:type_name -> rps.Player
+	47, // 37: rps.Tournament.matches:type_name -> rps.TournamentMatch
+	51, // 38: rps.Tournament.standings:type_name -> rps.TournamentStanding
+	22, // 39: rps.Tournament.owner:type_name -> rps.Player
+	22, // 40: rps.TournamentMatch.players:type_name -> rps.Player
+	2,  // 41: rps.TournamentMatch.state:type_name -> rps.EnumRoomState
+	5,  // 42: rps.TournamentMatch.bracket:type_name -> rps.EnumBracket
+	48, // 43: rps.TournamentMatch.sources:type_name -> rps.MatchSource
+	50, // 44: rps.Bracket.roots:type_name -> rps.BracketNode
+	47, // 45: rps.BracketNode.match:type_name -> rps.TournamentMatch
+	50, // 46: rps.BracketNode.children:type_name -> rps.BracketNode
+	22, // 47: rps.TournamentStanding.player:type_name -> rps.Player
+	7,  // 48: rps.Gamer.Auth:input_type -> rps.AuthRequest
+	8,  // 49: rps.Gamer.Challenge:input_type -> rps.ChallengeRequest
+	11, // 50: rps.Gamer.Ready:input_type -> rps.ReadyRequest
+	17, // 51: rps.Gamer.Play:input_type -> rps.Choise
+	13, // 52: rps.Gamer.Rulesets:input_type -> rps.RulesetsRequest
+	24, // 53: rps.Gamer.CreateRoom:input_type -> rps.CreateRoomRequest
+	25, // 54: rps.Gamer.ListRooms:input_type -> rps.ListRoomsRequest
+	27, // 55: rps.Gamer.JoinRoom:input_type -> rps.JoinRoomRequest
+	28, // 56: rps.Gamer.LeaveRoom:input_type -> rps.LeaveRoomRequest
+	32, // 57: rps.Gamer.FindMatch:input_type -> rps.FindMatchRequest
+	34, // 58: rps.Gamer.GetPlayerStats:input_type -> rps.GetPlayerStatsRequest
+	37, // 59: rps.Gamer.Leaderboard:input_type -> rps.LeaderboardRequest
+	40, // 60: rps.Gamer.CreateTournament:input_type -> rps.CreateTournamentRequest
+	41, // 61: rps.Gamer.JoinTournament:input_type -> rps.JoinTournamentRequest
+	42, // 62: rps.Gamer.StartTournament:input_type -> rps.StartTournamentRequest
+	43, // 63: rps.Gamer.GetTournament:input_type -> rps.GetTournamentRequest
+	44, // 64: rps.Gamer.ListTournaments:input_type -> rps.ListTournamentsRequest
+	43, // 65: rps.Gamer.GetBracket:input_type -> rps.GetTournamentRequest
+	10, // 66: rps.Gamer.Auth:output_type -> rps.AuthResponse
+	9,  // 67: rps.Gamer.Challenge:output_type -> rps.ChallengeResponse
+	12, // 68: rps.Gamer.Ready:output_type -> rps.ReadyResponse
+	20, // 69: rps.Gamer.Play:output_type -> rps.Score
+	14, // 70: rps.Gamer.Rulesets:output_type -> rps.RulesetsResponse
+	30, // 71: rps.Gamer.CreateRoom:output_type -> rps.Room
+	26, // 72: rps.Gamer.ListRooms:output_type -> rps.ListRoomsResponse
+	30, // 73: rps.Gamer.JoinRoom:output_type -> rps.Room
+	29, // 74: rps.Gamer.LeaveRoom:output_type -> rps.LeaveRoomResponse
+	33, // 75: rps.Gamer.FindMatch:output_type -> rps.MatchUpdate
+	35, // 76: rps.Gamer.GetPlayerStats:output_type -> rps.PlayerStats
+	38, // 77: rps.Gamer.Leaderboard:output_type -> rps.LeaderboardResponse
+	46, // 78: rps.Gamer.CreateTournament:output_type -> rps.Tournament
+	46, // 79: rps.Gamer.JoinTournament:output_type -> rps.Tournament
+	46, // 80: rps.Gamer.StartTournament:output_type -> rps.Tournament
+	46, // 81: rps.Gamer.GetTournament:output_type -> rps.Tournament
+	45, // 82: rps.Gamer.ListTournaments:output_type -> rps.ListTournamentsResponse
+	49, // 83: rps.Gamer.GetBracket:output_type -> rps.Bracket
+	66, // [66:84] is the sub-list for method output_type
+	48, // [48:66] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
+}
+
+func init() { file_rps_proto_init() }
+func file_rps_proto_init() {
 	if File_rps_proto != nil {
 		return
 	}
@@ -782,8 +4368,164 @@ func file_rps_proto_init() {
 				return nil
 			}
 		}
-		file_rps_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthResponse); i {
+		file_rps_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChallengeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChallengeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RulesetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RulesetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ruleset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Weapon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Choise); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Commitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reveal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Score); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -794,8 +4536,8 @@ func file_rps_proto_init() {
 				return nil
 			}
 		}
-		file_rps_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadyRequest); i {
+		file_rps_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -806,8 +4548,8 @@ func file_rps_proto_init() {
 				return nil
 			}
 		}
-		file_rps_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadyResponse); i {
+		file_rps_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -818,8 +4560,8 @@ func file_rps_proto_init() {
 				return nil
 			}
 		}
-		file_rps_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Choise); i {
+		file_rps_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -830,8 +4572,8 @@ func file_rps_proto_init() {
 				return nil
 			}
 		}
-		file_rps_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Score); i {
+		file_rps_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -842,8 +4584,8 @@ func file_rps_proto_init() {
 				return nil
 			}
 		}
-		file_rps_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundResult); i {
+		file_rps_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -854,8 +4596,8 @@ func file_rps_proto_init() {
 				return nil
 			}
 		}
-		file_rps_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Player); i {
+		file_rps_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRoomRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -866,8 +4608,284 @@ func file_rps_proto_init() {
 				return nil
 			}
 		}
-		file_rps_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameResult); i {
+		file_rps_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Room); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindMatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RatingChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTournamentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTournamentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tournament); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bracket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BracketNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rps_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentStanding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -884,8 +4902,8 @@ func file_rps_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rps_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      7,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
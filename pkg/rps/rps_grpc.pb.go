@@ -19,10 +19,44 @@ const _ = grpc.SupportPackageIsVersion6
 type GamerClient interface {
 	// Auth authenticates a player in the game.
 	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// Challenge returns a random challenge for the player to sign with the player's key
+	// and pass to Auth to reconnect.
+	Challenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error)
 	// Ready sends a message to server that the player is ready to start the game.
 	Ready(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*ReadyResponse, error)
 	// Play starts the game.
 	Play(ctx context.Context, opts ...grpc.CallOption) (Gamer_PlayClient, error)
+	// Rulesets lists the game rulesets available on the server.
+	Rulesets(ctx context.Context, in *RulesetsRequest, opts ...grpc.CallOption) (*RulesetsResponse, error)
+	// CreateRoom creates a new game room and joins the player to it.
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*Room, error)
+	// ListRooms lists the game rooms.
+	ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error)
+	// JoinRoom joins the player to the game room.
+	JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*Room, error)
+	// LeaveRoom removes the player from the game room.
+	LeaveRoom(ctx context.Context, in *LeaveRoomRequest, opts ...grpc.CallOption) (*LeaveRoomResponse, error)
+	// FindMatch puts the player into the matchmaking queue and reports the queue position
+	// until the player is matched with other players in a new game room.
+	FindMatch(ctx context.Context, in *FindMatchRequest, opts ...grpc.CallOption) (Gamer_FindMatchClient, error)
+	// GetPlayerStats returns the rating and the game history of a player.
+	GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error)
+	// Leaderboard returns a page of the players ranked by their results in the completed games.
+	Leaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
+	// CreateTournament creates a tournament open for registration.
+	CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	// JoinTournament registers the player in the tournament.
+	JoinTournament(ctx context.Context, in *JoinTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	// StartTournament closes the registration and starts the matches of the tournament.
+	// Only the player who created the tournament can start it.
+	StartTournament(ctx context.Context, in *StartTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	// GetTournament returns the tournament with its matches and standings.
+	GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	// ListTournaments lists the tournaments.
+	ListTournaments(ctx context.Context, in *ListTournamentsRequest, opts ...grpc.CallOption) (*ListTournamentsResponse, error)
+	// GetBracket returns the matches of the tournament as a tree
+	// where the children of a match are the matches whose winners play it.
+	GetBracket(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*Bracket, error)
 }
 
 type gamerClient struct {
@@ -42,6 +76,15 @@ func (c *gamerClient) Auth(ctx context.Context, in *AuthRequest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *gamerClient) Challenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error) {
+	out := new(ChallengeResponse)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/Challenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gamerClient) Ready(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*ReadyResponse, error) {
 	out := new(ReadyResponse)
 	err := c.cc.Invoke(ctx, "/rps.Gamer/Ready", in, out, opts...)
@@ -82,16 +125,199 @@ func (x *gamerPlayClient) Recv() (*Score, error) {
 	return m, nil
 }
 
+func (c *gamerClient) Rulesets(ctx context.Context, in *RulesetsRequest, opts ...grpc.CallOption) (*RulesetsResponse, error) {
+	out := new(RulesetsResponse)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/Rulesets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*Room, error) {
+	out := new(Room)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/CreateRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error) {
+	out := new(ListRoomsResponse)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/ListRooms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*Room, error) {
+	out := new(Room)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/JoinRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) LeaveRoom(ctx context.Context, in *LeaveRoomRequest, opts ...grpc.CallOption) (*LeaveRoomResponse, error) {
+	out := new(LeaveRoomResponse)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/LeaveRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) FindMatch(ctx context.Context, in *FindMatchRequest, opts ...grpc.CallOption) (Gamer_FindMatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Gamer_serviceDesc.Streams[1], "/rps.Gamer/FindMatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gamerFindMatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gamer_FindMatchClient interface {
+	Recv() (*MatchUpdate, error)
+	grpc.ClientStream
+}
+
+type gamerFindMatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *gamerFindMatchClient) Recv() (*MatchUpdate, error) {
+	m := new(MatchUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gamerClient) GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error) {
+	out := new(PlayerStats)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/GetPlayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) Leaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error) {
+	out := new(LeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/Leaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/CreateTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) JoinTournament(ctx context.Context, in *JoinTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/JoinTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) StartTournament(ctx context.Context, in *StartTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/StartTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/GetTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) ListTournaments(ctx context.Context, in *ListTournamentsRequest, opts ...grpc.CallOption) (*ListTournamentsResponse, error) {
+	out := new(ListTournamentsResponse)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/ListTournaments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamerClient) GetBracket(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*Bracket, error) {
+	out := new(Bracket)
+	err := c.cc.Invoke(ctx, "/rps.Gamer/GetBracket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GamerServer is the server API for Gamer service.
 // All implementations must embed UnimplementedGamerServer
 // for forward compatibility
 type GamerServer interface {
 	// Auth authenticates a player in the game.
 	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
+	// Challenge returns a random challenge for the player to sign with the player's key
+	// and pass to Auth to reconnect.
+	Challenge(context.Context, *ChallengeRequest) (*ChallengeResponse, error)
 	// Ready sends a message to server that the player is ready to start the game.
 	Ready(context.Context, *ReadyRequest) (*ReadyResponse, error)
 	// Play starts the game.
 	Play(Gamer_PlayServer) error
+	// Rulesets lists the game rulesets available on the server.
+	Rulesets(context.Context, *RulesetsRequest) (*RulesetsResponse, error)
+	// CreateRoom creates a new game room and joins the player to it.
+	CreateRoom(context.Context, *CreateRoomRequest) (*Room, error)
+	// ListRooms lists the game rooms.
+	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
+	// JoinRoom joins the player to the game room.
+	JoinRoom(context.Context, *JoinRoomRequest) (*Room, error)
+	// LeaveRoom removes the player from the game room.
+	LeaveRoom(context.Context, *LeaveRoomRequest) (*LeaveRoomResponse, error)
+	// FindMatch puts the player into the matchmaking queue and reports the queue position
+	// until the player is matched with other players in a new game room.
+	FindMatch(*FindMatchRequest, Gamer_FindMatchServer) error
+	// GetPlayerStats returns the rating and the game history of a player.
+	GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*PlayerStats, error)
+	// Leaderboard returns a page of the players ranked by their results in the completed games.
+	Leaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error)
+	// CreateTournament creates a tournament open for registration.
+	CreateTournament(context.Context, *CreateTournamentRequest) (*Tournament, error)
+	// JoinTournament registers the player in the tournament.
+	JoinTournament(context.Context, *JoinTournamentRequest) (*Tournament, error)
+	// StartTournament closes the registration and starts the matches of the tournament.
+	// Only the player who created the tournament can start it.
+	StartTournament(context.Context, *StartTournamentRequest) (*Tournament, error)
+	// GetTournament returns the tournament with its matches and standings.
+	GetTournament(context.Context, *GetTournamentRequest) (*Tournament, error)
+	// ListTournaments lists the tournaments.
+	ListTournaments(context.Context, *ListTournamentsRequest) (*ListTournamentsResponse, error)
+	// GetBracket returns the matches of the tournament as a tree
+	// where the children of a match are the matches whose winners play it.
+	GetBracket(context.Context, *GetTournamentRequest) (*Bracket, error)
 	mustEmbedUnimplementedGamerServer()
 }
 
@@ -102,12 +328,57 @@ type UnimplementedGamerServer struct {
 func (*UnimplementedGamerServer) Auth(context.Context, *AuthRequest) (*AuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
 }
+func (*UnimplementedGamerServer) Challenge(context.Context, *ChallengeRequest) (*ChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Challenge not implemented")
+}
 func (*UnimplementedGamerServer) Ready(context.Context, *ReadyRequest) (*ReadyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ready not implemented")
 }
 func (*UnimplementedGamerServer) Play(Gamer_PlayServer) error {
 	return status.Errorf(codes.Unimplemented, "method Play not implemented")
 }
+func (*UnimplementedGamerServer) Rulesets(context.Context, *RulesetsRequest) (*RulesetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rulesets not implemented")
+}
+func (*UnimplementedGamerServer) CreateRoom(context.Context, *CreateRoomRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (*UnimplementedGamerServer) ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRooms not implemented")
+}
+func (*UnimplementedGamerServer) JoinRoom(context.Context, *JoinRoomRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
+}
+func (*UnimplementedGamerServer) LeaveRoom(context.Context, *LeaveRoomRequest) (*LeaveRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveRoom not implemented")
+}
+func (*UnimplementedGamerServer) FindMatch(*FindMatchRequest, Gamer_FindMatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindMatch not implemented")
+}
+func (*UnimplementedGamerServer) GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*PlayerStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
+func (*UnimplementedGamerServer) Leaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leaderboard not implemented")
+}
+func (*UnimplementedGamerServer) CreateTournament(context.Context, *CreateTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
+}
+func (*UnimplementedGamerServer) JoinTournament(context.Context, *JoinTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTournament not implemented")
+}
+func (*UnimplementedGamerServer) StartTournament(context.Context, *StartTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTournament not implemented")
+}
+func (*UnimplementedGamerServer) GetTournament(context.Context, *GetTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournament not implemented")
+}
+func (*UnimplementedGamerServer) ListTournaments(context.Context, *ListTournamentsRequest) (*ListTournamentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTournaments not implemented")
+}
+func (*UnimplementedGamerServer) GetBracket(context.Context, *GetTournamentRequest) (*Bracket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBracket not implemented")
+}
 func (*UnimplementedGamerServer) mustEmbedUnimplementedGamerServer() {}
 
 func RegisterGamerServer(s *grpc.Server, srv GamerServer) {
@@ -132,6 +403,24 @@ func _Gamer_Auth_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gamer_Challenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).Challenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/Challenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).Challenge(ctx, req.(*ChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Gamer_Ready_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadyRequest)
 	if err := dec(in); err != nil {
@@ -176,6 +465,261 @@ func (x *gamerPlayServer) Recv() (*Choise, error) {
 	return m, nil
 }
 
+func _Gamer_Rulesets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RulesetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).Rulesets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/Rulesets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).Rulesets(ctx, req.(*RulesetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/CreateRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_ListRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).ListRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/ListRooms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).ListRooms(ctx, req.(*ListRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).JoinRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/JoinRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).JoinRoom(ctx, req.(*JoinRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_LeaveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).LeaveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/LeaveRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).LeaveRoom(ctx, req.(*LeaveRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_FindMatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindMatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GamerServer).FindMatch(m, &gamerFindMatchServer{stream})
+}
+
+type Gamer_FindMatchServer interface {
+	Send(*MatchUpdate) error
+	grpc.ServerStream
+}
+
+type gamerFindMatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *gamerFindMatchServer) Send(m *MatchUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gamer_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/GetPlayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).GetPlayerStats(ctx, req.(*GetPlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_Leaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).Leaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/Leaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).Leaderboard(ctx, req.(*LeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).CreateTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/CreateTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_JoinTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).JoinTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/JoinTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).JoinTournament(ctx, req.(*JoinTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_StartTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).StartTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/StartTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).StartTournament(ctx, req.(*StartTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_GetTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).GetTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/GetTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).GetTournament(ctx, req.(*GetTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_ListTournaments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTournamentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).ListTournaments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/ListTournaments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).ListTournaments(ctx, req.(*ListTournamentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gamer_GetBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamerServer).GetBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rps.Gamer/GetBracket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamerServer).GetBracket(ctx, req.(*GetTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Gamer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rps.Gamer",
 	HandlerType: (*GamerServer)(nil),
@@ -184,10 +728,66 @@ var _Gamer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Auth",
 			Handler:    _Gamer_Auth_Handler,
 		},
+		{
+			MethodName: "Challenge",
+			Handler:    _Gamer_Challenge_Handler,
+		},
 		{
 			MethodName: "Ready",
 			Handler:    _Gamer_Ready_Handler,
 		},
+		{
+			MethodName: "Rulesets",
+			Handler:    _Gamer_Rulesets_Handler,
+		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _Gamer_CreateRoom_Handler,
+		},
+		{
+			MethodName: "ListRooms",
+			Handler:    _Gamer_ListRooms_Handler,
+		},
+		{
+			MethodName: "JoinRoom",
+			Handler:    _Gamer_JoinRoom_Handler,
+		},
+		{
+			MethodName: "LeaveRoom",
+			Handler:    _Gamer_LeaveRoom_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _Gamer_GetPlayerStats_Handler,
+		},
+		{
+			MethodName: "Leaderboard",
+			Handler:    _Gamer_Leaderboard_Handler,
+		},
+		{
+			MethodName: "CreateTournament",
+			Handler:    _Gamer_CreateTournament_Handler,
+		},
+		{
+			MethodName: "JoinTournament",
+			Handler:    _Gamer_JoinTournament_Handler,
+		},
+		{
+			MethodName: "StartTournament",
+			Handler:    _Gamer_StartTournament_Handler,
+		},
+		{
+			MethodName: "GetTournament",
+			Handler:    _Gamer_GetTournament_Handler,
+		},
+		{
+			MethodName: "ListTournaments",
+			Handler:    _Gamer_ListTournaments_Handler,
+		},
+		{
+			MethodName: "GetBracket",
+			Handler:    _Gamer_GetBracket_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -196,6 +796,11 @@ var _Gamer_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "FindMatch",
+			Handler:       _Gamer_FindMatch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rps.proto",
 }
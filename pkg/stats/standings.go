@@ -0,0 +1,183 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package stats
+
+import (
+	"sort"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/rating"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// Standing is a player's summary of the completed games.
+type Standing struct {
+	// Player identifies the player.
+	Player string
+
+	// Rating is the player's current rating,
+	// which is rated only by the games of the ruleset in the standings of a ruleset.
+	Rating rating.Rating
+
+	// Games is the number of completed games.
+	Games int
+
+	// Wins is the number of won games.
+	Wins int
+
+	// Losses is the number of lost games.
+	Losses int
+
+	// Draws is the number of drawn games.
+	Draws int
+}
+
+// WinRate returns the share of won games from 0 to 1.
+func (s Standing) WinRate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.Wins) / float64(s.Games)
+}
+
+// Filter selects the games for the standings.
+type Filter struct {
+	// Ruleset selects the games of the ruleset, all the games if it is empty.
+	Ruleset string
+
+	// Since selects the games finished since the time, all the games if it is zero.
+	Since time.Time
+}
+
+// Standings returns the standings of the players who played the games selected by the filter
+// in no particular order. The ratings are the players' ratings at the time rated by the rating system.
+func (s *Store) Standings(f Filter, sys rating.System, now time.Time) []Standing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ratings, last := s.data.Ratings, make(map[string]time.Time)
+	if f.Ruleset != "" {
+		ratings, last = s.rulesetRatings(f.Ruleset, sys)
+	}
+
+	byPlayer := make(map[string]*Standing)
+	for _, g := range s.data.Games {
+		if f.Ruleset != "" && g.Ruleset != f.Ruleset || g.Finished.Before(f.Since) {
+			continue
+		}
+		for _, r := range g.Results {
+			st, ok := byPlayer[r.Player]
+			if !ok {
+				t, ok := last[r.Player]
+				if !ok {
+					t = s.lastPlayed(r.Player)
+				}
+				st = &Standing{
+					Player: r.Player,
+					Rating: decay(sys, ratings[r.Player], now.Sub(t)),
+				}
+				byPlayer[r.Player] = st
+			}
+
+			st.Games++
+			switch r.Status {
+			case pb.EnumStatus_Winner:
+				st.Wins++
+			case pb.EnumStatus_Looser:
+				st.Losses++
+			case pb.EnumStatus_Draw:
+				st.Draws++
+			}
+		}
+	}
+
+	standings := make([]Standing, 0, len(byPlayer))
+	for _, st := range byPlayer {
+		standings = append(standings, *st)
+	}
+	return standings
+}
+
+// rulesetRatings rates the players only by the games of the ruleset
+// and returns their ratings and the times their last games of the ruleset were over.
+// mu must be held.
+func (s *Store) rulesetRatings(ruleset string, sys rating.System) (map[string]rating.Rating, map[string]time.Time) {
+	ratings := make(map[string]rating.Rating)
+	last := make(map[string]time.Time)
+	for _, g := range s.data.Games {
+		if g.Ruleset != ruleset {
+			continue
+		}
+
+		before := make(map[string]rating.Rating, len(g.Results))
+		for _, r := range g.Results {
+			before[r.Player] = sys.Initial()
+			if rt, ok := ratings[r.Player]; ok {
+				before[r.Player] = decay(sys, rt, g.Finished.Sub(last[r.Player]))
+			}
+		}
+
+		for p, rt := range rating.Rate(sys, before, g.Scores()) {
+			ratings[p] = rt
+			last[p] = g.Finished
+		}
+	}
+	return ratings, last
+}
+
+// Order is an order of the standings, the best first.
+type Order int
+
+// Orders of the standings.
+const (
+	ByRating Order = iota
+	ByWins
+	ByWinRate
+	ByGames
+)
+
+// Sort sorts the standings in the order.
+// The ties are broken by the rating, then by the number of wins, then by the player.
+func Sort(standings []Standing, order Order) {
+	key := func(s Standing) float64 {
+		switch order {
+		case ByWins:
+			return float64(s.Wins)
+		case ByWinRate:
+			return s.WinRate()
+		case ByGames:
+			return float64(s.Games)
+		default:
+			return s.Rating.Value
+		}
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if ka, kb := key(a), key(b); ka != kb {
+			return ka > kb
+		}
+		if a.Rating.Value != b.Rating.Value {
+			return a.Rating.Value > b.Rating.Value
+		}
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		return a.Player < b.Player
+	})
+}
@@ -0,0 +1,173 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/rating"
+)
+
+func TestStandings(t *testing.T) {
+	s, err := Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, g := range []*Game{
+		game("classic", t0, "a", "b"),
+		game("lizard", t0.Add(time.Hour), "b", "c"),
+		game("lizard", t0.Add(2*time.Hour), "b", "c"),
+	} {
+		if err := s.Record(g); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sys := rating.Elo{K: 32}
+	now := t0.Add(3 * time.Hour)
+	tests := []struct {
+		name   string
+		filter Filter
+		want   map[string]Standing
+	}{
+		{
+			name: "all",
+			want: map[string]Standing{
+				"a": {Rating: rating.Rating{Value: 1516}, Games: 1, Wins: 1},
+				"b": {Rating: rating.Rating{Value: 1516}, Games: 3, Wins: 2, Losses: 1},
+				"c": {Rating: rating.Rating{Value: 1484}, Games: 2, Losses: 2},
+			},
+		},
+		{
+			name:   "ruleset",
+			filter: Filter{Ruleset: "classic"},
+			want: map[string]Standing{
+				"a": {Rating: rating.Rating{Value: 1516}, Games: 1, Wins: 1},
+				"b": {Rating: rating.Rating{Value: 1484}, Games: 1, Losses: 1},
+			},
+		},
+		{
+			name:   "since",
+			filter: Filter{Since: t0.Add(90 * time.Minute)},
+			want: map[string]Standing{
+				"b": {Rating: rating.Rating{Value: 1516}, Games: 1, Wins: 1},
+				"c": {Rating: rating.Rating{Value: 1484}, Games: 1, Losses: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.Standings(tt.filter, sys, now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Standings() = %+v, want %d players", got, len(tt.want))
+			}
+			for _, st := range got {
+				want := tt.want[st.Player]
+				want.Player = st.Player
+				if st != want {
+					t.Errorf("standing = %+v, want %+v", st, want)
+				}
+			}
+		})
+	}
+}
+
+func TestStandingsRulesetRatings(t *testing.T) {
+	s, err := Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The recorded ratings are rated by the games of both rulesets,
+	// the ratings of a ruleset are rated only by its games.
+	for _, g := range []*Game{
+		game("classic", t0, "a", "b"),
+		game("classic", t0.Add(time.Hour), "a", "b"),
+		game("lizard", t0.Add(2*time.Hour), "b", "a"),
+	} {
+		if err := s.Record(g); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := s.Standings(Filter{Ruleset: "classic"}, rating.Elo{K: 32}, t0.Add(3*time.Hour))
+	Sort(got, ByRating)
+	if len(got) != 2 || got[0].Player != "a" || got[1].Player != "b" {
+		t.Fatalf("Standings() = %+v, want a ahead of b", got)
+	}
+	if got[0].Rating.Value <= 1516 || got[1].Rating.Value >= 1484 {
+		t.Errorf("ratings = %v, %v, want the ratings after two classic games", got[0].Rating.Value, got[1].Rating.Value)
+	}
+}
+
+func TestStandingsDecay(t *testing.T) {
+	s, err := Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Record(game("classic", t0, "a", "b")); err != nil {
+		t.Fatal(err)
+	}
+
+	sys := rating.Glicko2{Tau: 0.5, Period: time.Hour}
+	now := t0.Add(100 * time.Hour)
+	for _, st := range s.Standings(Filter{}, sys, now) {
+		if want := s.Current(st.Player, sys, now); st.Rating != want {
+			t.Errorf("rating of %s = %v, want the current %v", st.Player, st.Rating, want)
+		}
+	}
+
+	f := Filter{Ruleset: "classic"}
+	fresh, idle := s.Standings(f, sys, t0), s.Standings(f, sys, now)
+	Sort(fresh, ByRating)
+	Sort(idle, ByRating)
+	if idle[0].Rating.Deviation <= fresh[0].Rating.Deviation {
+		t.Errorf("ruleset rating deviation after the idle time = %v, want more than %v", idle[0].Rating.Deviation, fresh[0].Rating.Deviation)
+	}
+}
+
+func TestSort(t *testing.T) {
+	standings := []Standing{
+		{Player: "a", Rating: rating.Rating{Value: 1500}, Games: 4, Wins: 1},
+		{Player: "b", Rating: rating.Rating{Value: 1600}, Games: 2, Wins: 1},
+		{Player: "c", Rating: rating.Rating{Value: 1400}, Games: 1, Wins: 1},
+		{Player: "d", Rating: rating.Rating{Value: 1400}, Games: 2, Wins: 2},
+		{Player: "e", Rating: rating.Rating{Value: 1400}, Games: 1, Wins: 1},
+	}
+
+	tests := []struct {
+		order Order
+		want  []string
+	}{
+		{order: ByRating, want: []string{"b", "a", "d", "c", "e"}},
+		{order: ByWins, want: []string{"d", "b", "a", "c", "e"}},
+		{order: ByWinRate, want: []string{"d", "c", "e", "b", "a"}},
+		{order: ByGames, want: []string{"a", "b", "d", "c", "e"}},
+	}
+
+	for _, tt := range tests {
+		Sort(standings, tt.order)
+		for i, st := range standings {
+			if st.Player != tt.want[i] {
+				t.Errorf("Sort(%d) = %+v, want the players %v", tt.order, standings, tt.want)
+				break
+			}
+		}
+	}
+}
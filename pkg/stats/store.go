@@ -0,0 +1,256 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package stats keeps the records of the completed games and the ratings of the players.
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/rating"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+// Result is a player's result of a completed game.
+type Result struct {
+	// Player identifies the player.
+	Player string
+
+	// Score is the player's final score.
+	Score int32
+
+	// Status is the player's final game status.
+	Status pb.EnumStatus
+
+	// Forfeited means the player left the game before it was over.
+	Forfeited bool
+
+	// Before is the player's rating before the game.
+	Before rating.Rating
+
+	// After is the player's rating after the game.
+	After rating.Rating
+}
+
+// Game is a record of a completed game.
+type Game struct {
+	// ID is a unique ID of the game.
+	ID string
+
+	// Room is the ID of the game room, which is unique only until the server restarts.
+	Room string
+
+	// Ruleset is the name of the game ruleset.
+	Ruleset string
+
+	// Rounds is the number of played rounds.
+	Rounds int32
+
+	// Finished is the time the game was over.
+	Finished time.Time
+
+	// Results are the players' results.
+	Results []Result
+}
+
+// Result returns the player's result of the game.
+func (g *Game) Result(player string) (Result, bool) {
+	for _, r := range g.Results {
+		if r.Player == player {
+			return r, true
+		}
+	}
+	return Result{}, false
+}
+
+// Scores returns the scores the players are rated by.
+// The players who have forfeited the game rank below all the others whatever their scores are.
+func (g *Game) Scores() map[string]int32 {
+	lowest := int32(0)
+	for _, r := range g.Results {
+		if r.Score < lowest {
+			lowest = r.Score
+		}
+	}
+
+	scores := make(map[string]int32, len(g.Results))
+	for _, r := range g.Results {
+		scores[r.Player] = r.Score
+		if r.Forfeited {
+			scores[r.Player] = lowest - 1
+		}
+	}
+	return scores
+}
+
+// Store keeps the games and the ratings in memory and optionally in a JSON file.
+// Store is safe for concurrent use.
+type Store struct {
+	path string
+	mu   sync.Mutex // protects data
+	data data
+}
+
+type data struct {
+	Games   []*Game
+	Ratings map[string]rating.Rating
+}
+
+// Open opens the store persisted in the file at the path, the empty path means memory only.
+// The file is created on the first record if it does not exist.
+func Open(path string) (*Store, error) {
+	s := &Store{
+		path: path,
+		data: data{
+			Ratings: make(map[string]rating.Rating),
+		},
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read stats: %w", err)
+	}
+	if err := json.Unmarshal(b, &s.data); err != nil {
+		return nil, fmt.Errorf("cannot parse stats %s: %w", path, err)
+	}
+	if s.data.Ratings == nil {
+		s.data.Ratings = make(map[string]rating.Rating)
+	}
+
+	return s, nil
+}
+
+// Rating returns the player's current rating.
+func (s *Store) Rating(player string) (rating.Rating, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.data.Ratings[player]
+	return r, ok
+}
+
+// Current returns the player's rating at the time rated by the rating system,
+// the initial rating if the player has not played yet.
+// The rating decays since the player's last game if the rating system supports it.
+func (s *Store) Current(player string, sys rating.System, now time.Time) rating.Rating {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.data.Ratings[player]
+	if !ok {
+		return sys.Initial()
+	}
+	return decay(sys, r, now.Sub(s.lastPlayed(player)))
+}
+
+// Record adds the game and sets the players' ratings to the ratings after the game.
+func (s *Store) Record(g *Game) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.Games = append(s.data.Games, g)
+	for _, r := range g.Results {
+		s.data.Ratings[r.Player] = r.After
+	}
+
+	return s.save()
+}
+
+// Games returns the games the player played, all the games if the player is empty.
+func (s *Store) Games(player string) []*Game {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var games []*Game
+	for _, g := range s.data.Games {
+		if _, ok := g.Result(player); ok || player == "" {
+			games = append(games, g)
+		}
+	}
+	return games
+}
+
+// LastPlayed returns the time the player's last game was over.
+func (s *Store) LastPlayed(player string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last := s.lastPlayed(player)
+	return last, !last.IsZero()
+}
+
+// lastPlayed returns the time the player's last game was over, the zero time if there is none.
+// mu must be held.
+func (s *Store) lastPlayed(player string) time.Time {
+	for i := len(s.data.Games) - 1; i >= 0; i-- {
+		if _, ok := s.data.Games[i].Result(player); ok {
+			return s.data.Games[i].Finished
+		}
+	}
+	return time.Time{}
+}
+
+// decay returns the rating after the idle time if the rating system supports decay.
+func decay(sys rating.System, r rating.Rating, idle time.Duration) rating.Rating {
+	if d, ok := sys.(rating.Decayer); ok {
+		return d.Decay(r, idle)
+	}
+	return r
+}
+
+// save writes the data to the file. mu must be held.
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(&s.data)
+	if err != nil {
+		return fmt.Errorf("cannot marshal stats: %w", err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("cannot save stats: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("cannot save stats: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("cannot save stats: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("cannot save stats: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package stats
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/rating"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+var t0 = time.Date(2020, 9, 1, 12, 0, 0, 0, time.UTC)
+
+// game returns a game of the ruleset the winner won against the loser.
+func game(ruleset string, finished time.Time, winner, loser string) *Game {
+	sys := rating.Elo{K: 32}
+	return &Game{
+		ID:       winner + "-" + loser + "-" + finished.Format(time.RFC3339),
+		Ruleset:  ruleset,
+		Rounds:   1,
+		Finished: finished,
+		Results: []Result{
+			{Player: winner, Score: 1, Status: pb.EnumStatus_Winner, Before: sys.Initial(), After: rating.Rating{Value: 1516}},
+			{Player: loser, Score: 0, Status: pb.EnumStatus_Looser, Before: sys.Initial(), After: rating.Rating{Value: 1484}},
+		},
+	}
+}
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	s, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Record(game("classic", t0, "a", "b")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Record(game("classic", t0.Add(time.Hour), "c", "a")); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(s.Games("")); got != 2 {
+		t.Errorf("len(Games(\"\")) = %d, want 2", got)
+	}
+	if got := len(s.Games("b")); got != 1 {
+		t.Errorf("len(Games(b)) = %d, want 1", got)
+	}
+	if r, ok := s.Rating("a"); !ok || r.Value != 1484 {
+		t.Errorf("Rating(a) = %v, %v, want the rating after the last game", r, ok)
+	}
+	if last, ok := s.LastPlayed("a"); !ok || !last.Equal(t0.Add(time.Hour)) {
+		t.Errorf("LastPlayed(a) = %v, %v, want %v", last, ok, t0.Add(time.Hour))
+	}
+	if _, ok := s.LastPlayed("d"); ok {
+		t.Error("LastPlayed() of a player who has not played is found")
+	}
+}
+
+func TestOpenCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	if err := ioutil.WriteFile(path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(path); err == nil {
+		t.Error("Open() of a corrupt file succeeded")
+	}
+
+	if _, err := Open(filepath.Join(t.TempDir(), "missing.json")); err != nil {
+		t.Errorf("Open() of a missing file failed: %v", err)
+	}
+}
+
+func TestCurrent(t *testing.T) {
+	s, err := Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Record(game("classic", t0, "a", "b")); err != nil {
+		t.Fatal(err)
+	}
+
+	elo := rating.Elo{K: 32}
+	if got := s.Current("a", elo, t0.Add(1000*time.Hour)); got.Value != 1516 {
+		t.Errorf("Current(a) = %v, want 1516", got.Value)
+	}
+	if got := s.Current("c", elo, t0); got != elo.Initial() {
+		t.Errorf("Current() of a new player = %v, want %v", got, elo.Initial())
+	}
+
+	glicko := rating.Glicko2{Tau: 0.5, Period: time.Hour}
+	want := glicko.Decay(rating.Rating{Value: 1516}, 10*time.Hour)
+	if got := s.Current("a", glicko, t0.Add(10*time.Hour)); got != want {
+		t.Errorf("Current(a) = %v, want decayed %v", got, want)
+	}
+}
+
+func TestScores(t *testing.T) {
+	g := &Game{
+		Results: []Result{
+			{Player: "a", Score: -1},
+			{Player: "b", Score: 3, Forfeited: true},
+			{Player: "c", Score: 1},
+		},
+	}
+
+	got := g.Scores()
+	if got["a"] != -1 || got["c"] != 1 {
+		t.Errorf("Scores() = %v, want the scores of the players who have not forfeited", got)
+	}
+	if got["b"] >= got["a"] {
+		t.Errorf("Scores() = %v, want the forfeited player below all the others", got)
+	}
+}
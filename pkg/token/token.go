@@ -0,0 +1,114 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package token issues and verifies the session tokens of the players.
+// A token is a JSON Web Token signed by HMAC-SHA256 whose subject is the player ID.
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalid means the token is malformed or its signature does not match.
+	ErrInvalid = errors.New("token is invalid")
+
+	// ErrExpired means the token is expired.
+	ErrExpired = errors.New("token is expired")
+)
+
+// header is the only supported JWT header.
+var header = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// claims are the JWT claims of a session token.
+type claims struct {
+	Subject   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Signer issues and verifies the tokens signed with a secret.
+// Signer is safe for concurrent use.
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewSigner creates a signer of the tokens valid for the ttl.
+func NewSigner(secret []byte, ttl time.Duration) *Signer {
+	return &Signer{
+		secret: secret,
+		ttl:    ttl,
+	}
+}
+
+// Issue returns a new token of the player.
+func (s *Signer) Issue(playerID string) (string, error) {
+	now := time.Now()
+	payload, err := json.Marshal(claims{
+		Subject:   playerID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.ttl).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal token claims: %w", err)
+	}
+
+	signed := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + s.sign(signed), nil
+}
+
+// Verify checks the token and returns the player ID.
+func (s *Signer) Verify(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != header {
+		return "", ErrInvalid
+	}
+
+	signed := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(s.sign(signed))) {
+		return "", ErrInvalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrInvalid
+	}
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil || c.Subject == "" {
+		return "", ErrInvalid
+	}
+	if time.Now().Unix() >= c.ExpiresAt {
+		return "", ErrExpired
+	}
+
+	return c.Subject, nil
+}
+
+// sign returns the encoded signature of the signed part of a token.
+func (s *Signer) sign(signed string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(signed))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,96 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package token
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// forge returns a token of the encoded header and payload signed with the secret.
+func forge(secret, header, payload string) string {
+	signed := header + "." + payload
+	return signed + "." + NewSigner([]byte(secret), time.Hour).sign(signed)
+}
+
+// encode encodes a segment of a token.
+func encode(segment string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(segment))
+}
+
+func TestVerify(t *testing.T) {
+	s := NewSigner([]byte("secret"), time.Hour)
+	valid, err := s.Issue("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := NewSigner([]byte("secret"), -time.Second).Issue("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewSigner([]byte("other"), time.Hour).Issue("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(valid, ".")
+	exp := strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)
+	payload := func(sub string) string {
+		return encode(`{"sub":"` + sub + `","exp":` + exp + `}`)
+	}
+	hs256 := encode(`{"alg":"HS256","typ":"JWT"}`)
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+		err   error
+	}{
+		{name: "valid", token: valid, want: "alice"},
+		{name: "forged with the key", token: forge("secret", hs256, payload("bob")), want: "bob"},
+		{name: "expired", token: expired, err: ErrExpired},
+		{name: "wrong key", token: other, err: ErrInvalid},
+		{
+			name:  "tampered payload",
+			token: parts[0] + "." + payload("mallory") + "." + parts[2],
+			err:   ErrInvalid,
+		},
+		{name: "tampered signature", token: parts[0] + "." + parts[1] + "." + parts[2][1:] + "A", err: ErrInvalid},
+		{name: "no signature", token: parts[0] + "." + parts[1] + ".", err: ErrInvalid},
+		{name: "alg none", token: forge("secret", encode(`{"alg":"none","typ":"JWT"}`), payload("alice")), err: ErrInvalid},
+		{name: "alg HS512", token: forge("secret", encode(`{"alg":"HS512","typ":"JWT"}`), payload("alice")), err: ErrInvalid},
+		{name: "no subject", token: forge("secret", hs256, payload("")), err: ErrInvalid},
+		{name: "payload is not JSON", token: forge("secret", hs256, encode("alice")), err: ErrInvalid},
+		{name: "empty", token: "", err: ErrInvalid},
+		{name: "two segments", token: parts[0] + "." + parts[1], err: ErrInvalid},
+		{name: "four segments", token: valid + "." + parts[2], err: ErrInvalid},
+		{name: "payload is not base64", token: forge("secret", hs256, "!!"), err: ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Verify(tt.token)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Errorf("Verify() = %q, %v, want %q, %v", got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tournament
+
+import "sort"
+
+var (
+	// SingleElimination is the tournament where the loser of a match drops out.
+	// The players are placed in the bracket by seed, so that the best seeds meet as late as possible,
+	// and the best seeds get byes if the number of players is not a power of two.
+	// The players are ranked by the round they reached, the later the better, then by seed.
+	SingleElimination Format = elimination{}
+
+	// DoubleElimination is the tournament where a player drops out after the second loss.
+	// The losers of the winners bracket continue in the losers bracket,
+	// and the winners of both brackets meet in the grand final.
+	// If the winner of the losers bracket wins the grand final, the bracket is reset:
+	// the players meet again in the second grand final.
+	// The players are ranked by the round they reached, the later the better, then by seed.
+	DoubleElimination Format = elimination{double: true}
+)
+
+type elimination struct {
+	double bool
+}
+
+func (e elimination) Name() string {
+	if e.double {
+		return "double-elimination"
+	}
+	return "single-elimination"
+}
+
+// Start implements Format, it schedules the whole bracket.
+func (e elimination) Start(players []string) ([]*Match, error) {
+	size := 2
+	for size < len(players) {
+		size *= 2
+	}
+
+	var matches []*Match
+	add := func(m *Match) int {
+		matches = append(matches, m)
+		return len(matches)
+	}
+
+	// Winners bracket, the first round places the players by seed.
+	var rounds [][]int
+	var round []int
+	order := seedOrder(size)
+	for i := 0; i < size; i += 2 {
+		m := &Match{Round: 1, Bracket: WinnersBracket}
+		for side, seed := range order[i : i+2] {
+			if seed < len(players) {
+				m.Players[side] = players[seed]
+			}
+		}
+		round = append(round, add(m))
+	}
+	rounds = append(rounds, round)
+
+	for r := 2; len(round) > 1; r++ {
+		var next []int
+		for i := 0; i < len(round); i += 2 {
+			next = append(next, add(&Match{
+				Round:   r,
+				Bracket: WinnersBracket,
+				Sources: [2]*Source{{Match: round[i]}, {Match: round[i+1]}},
+			}))
+		}
+		round = next
+		rounds = append(rounds, round)
+	}
+
+	if !e.double {
+		return matches, nil
+	}
+
+	// Losers bracket: the losers of the first winners round play each other,
+	// then every next winners round drops its losers against the survivors of the losers bracket
+	// in the reverse order to avoid early rematches, and the survivors play each other in between.
+	var losers *Source
+	if len(rounds) == 1 {
+		losers = &Source{Match: rounds[0][0], Loser: true}
+	} else {
+		var survivors []*Source
+		for _, id := range rounds[0] {
+			survivors = append(survivors, &Source{Match: id, Loser: true})
+		}
+
+		lr := 0
+		for r := 1; r < len(rounds); r++ {
+			lr++
+			var next []*Source
+			for i := 0; i < len(survivors); i += 2 {
+				next = append(next, &Source{Match: add(&Match{
+					Round:   lr,
+					Bracket: LosersBracket,
+					Sources: [2]*Source{survivors[i], survivors[i+1]},
+				})})
+			}
+			survivors = next
+
+			lr++
+			dropped := rounds[r]
+			next = nil
+			for i, src := range survivors {
+				next = append(next, &Source{Match: add(&Match{
+					Round:   lr,
+					Bracket: LosersBracket,
+					Sources: [2]*Source{src, {Match: dropped[len(dropped)-1-i], Loser: true}},
+				})})
+			}
+			survivors = next
+		}
+		losers = survivors[0]
+	}
+
+	add(&Match{
+		Round:   len(rounds) + 1,
+		Bracket: GrandFinal,
+		Sources: [2]*Source{{Match: round[0]}, losers},
+	})
+
+	return matches, nil
+}
+
+// Next implements Format, the whole bracket is scheduled at the start
+// except for the second grand final of a double elimination.
+func (e elimination) Next(_ []string, _ []*Match, finished *Match) []*Match {
+	if !e.double || finished.Bracket != GrandFinal || finished.Winner != 1 {
+		return nil
+	}
+	if src := finished.Sources[0]; src == nil || src.Loser {
+		// The second grand final is over.
+		return nil
+	}
+
+	// The winner of the winners bracket has lost for the first time.
+	return []*Match{{
+		Round:   finished.Round + 1,
+		Bracket: GrandFinal,
+		Sources: [2]*Source{{Match: finished.ID, Loser: true}, {Match: finished.ID}},
+	}}
+}
+
+// Standings implements Format.
+func (e elimination) Standings(players []string, matches []*Match) []Standing {
+	standings := tally(players, matches)
+
+	seed := make(map[string]int, len(players))
+	for i, p := range players {
+		seed[p] = i
+	}
+
+	// The progress of a player is the latest stage of the bracket the player has reached,
+	// the losers bracket follows the winners bracket and the grand finals follow both.
+	var winnersRounds, losersRounds int
+	for _, m := range matches {
+		switch {
+		case m.Bracket == WinnersBracket && m.Round > winnersRounds:
+			winnersRounds = m.Round
+		case m.Bracket == LosersBracket && m.Round > losersRounds:
+			losersRounds = m.Round
+		}
+	}
+	stage := func(m *Match) int {
+		switch m.Bracket {
+		case LosersBracket:
+			return winnersRounds + m.Round
+		case GrandFinal:
+			return losersRounds + m.Round
+		default:
+			return m.Round
+		}
+	}
+
+	progress := make(map[string]int, len(players))
+	for _, m := range matches {
+		for side, p := range m.Players {
+			if p == "" {
+				continue
+			}
+			st := stage(m)
+			if m.Done && m.Winner == side {
+				st++
+			}
+			if st > progress[p] {
+				progress[p] = st
+			}
+		}
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if pa, pb := progress[a.Player], progress[b.Player]; pa != pb {
+			return pa > pb
+		}
+		return seed[a.Player] < seed[b.Player]
+	})
+
+	return standings
+}
+
+// seedOrder returns the 0-based seeds in the order of the bracket positions of the size,
+// so that the seeds 1 and 2 can only meet in the final, the seeds 1 to 4 in the semifinals and so on.
+func seedOrder(size int) []int {
+	order := []int{0}
+	for n := 2; n <= size; n *= 2 {
+		next := make([]int, 0, n)
+		for _, s := range order {
+			next = append(next, s, n-1-s)
+		}
+		order = next
+	}
+	return order
+}
@@ -0,0 +1,141 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tournament
+
+import "testing"
+
+func TestSeedOrder(t *testing.T) {
+	want := []int{0, 7, 3, 4, 1, 6, 2, 5}
+	got := seedOrder(8)
+	if len(got) != len(want) {
+		t.Fatalf("seedOrder(8) = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("seedOrder(8) = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSingleElimination(t *testing.T) {
+	tr, err := New(SingleElimination, []string{"a", "b", "c", "d", "e"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(tr.Matches()); got != 7 {
+		t.Fatalf("bracket of 5 players has %d matches, want 7", got)
+	}
+
+	// The best seeds get byes.
+	for _, id := range []int{1, 3, 4} {
+		if m := tr.Match(id); !m.Done || !m.Forfeit || m.Winner < 0 {
+			t.Errorf("match %d is not a bye: %+v", id, m)
+		}
+	}
+	assertPending(t, tr, 2, 6)
+
+	report(t, tr, 2, "d", "e", 1, 2)
+	report(t, tr, 6, "b", "c", 0, 2)
+	report(t, tr, 5, "a", "e", 2, 1)
+	report(t, tr, 7, "a", "c", 1, 1) // a draw is won by the better seed
+
+	if !tr.Over() {
+		t.Fatal("tournament is not over")
+	}
+	if m := tr.Match(7); m.Winner != 0 {
+		t.Errorf("final is won by %d, want the better seed", m.Winner)
+	}
+	assertRanking(t, tr.Standings(), "a", "c", "b", "e", "d")
+}
+
+func TestDoubleElimination(t *testing.T) {
+	tests := []struct {
+		name    string
+		final   [2]int32
+		reset   *[2]int32
+		ranking []string
+	}{
+		{
+			name:    "winners bracket champion",
+			final:   [2]int32{2, 0},
+			ranking: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:    "bracket reset won by the champion",
+			final:   [2]int32{0, 2},
+			reset:   &[2]int32{2, 1},
+			ranking: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:    "bracket reset won by the challenger",
+			final:   [2]int32{0, 2},
+			reset:   &[2]int32{1, 2},
+			ranking: []string{"b", "a", "c", "d"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := New(DoubleElimination, []string{"a", "b", "c", "d"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			report(t, tr, 1, "a", "d", 2, 0)
+			report(t, tr, 2, "b", "c", 2, 1)
+			report(t, tr, 3, "a", "b", 2, 0)
+			report(t, tr, 4, "d", "c", 0, 2)
+			report(t, tr, 5, "c", "b", 0, 2)
+			report(t, tr, 6, "a", "b", tt.final[0], tt.final[1])
+
+			if tt.reset == nil {
+				if len(tr.Matches()) != 6 {
+					t.Fatalf("the bracket is reset after the champion won the grand final")
+				}
+			} else {
+				m := tr.Match(7)
+				if m == nil || m.Bracket != GrandFinal {
+					t.Fatalf("the bracket is not reset after the champion lost the grand final")
+				}
+				report(t, tr, 7, "a", "b", tt.reset[0], tt.reset[1])
+				if len(tr.Matches()) != 7 {
+					t.Fatalf("the bracket is reset twice")
+				}
+			}
+
+			if !tr.Over() {
+				t.Fatal("tournament is not over")
+			}
+			assertRanking(t, tr.Standings(), tt.ranking...)
+		})
+	}
+}
+
+func TestDoubleEliminationTwoPlayers(t *testing.T) {
+	tr, err := New(DoubleElimination, []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	report(t, tr, 1, "a", "b", 0, 1)
+	report(t, tr, 2, "b", "a", 0, 1)
+	report(t, tr, 3, "b", "a", 1, 0)
+	if !tr.Over() {
+		t.Fatal("tournament is not over")
+	}
+	assertRanking(t, tr.Standings(), "b", "a")
+}
@@ -0,0 +1,108 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tournament
+
+import "sort"
+
+// RoundRobin is the tournament where every player plays every other player once.
+// The players are ranked by the points, then by the points in the matches between the tied players,
+// then by the round differential, then by seed.
+var RoundRobin Format = roundRobin{}
+
+type roundRobin struct{}
+
+func (roundRobin) Name() string { return "round-robin" }
+
+// Start implements Format.
+// It schedules all the matches by the circle method, so that every round
+// consists of disjoint matches, a player with no opponent in a round rests.
+func (roundRobin) Start(players []string) ([]*Match, error) {
+	circle := append([]string(nil), players...)
+	if len(circle)%2 == 1 {
+		circle = append(circle, "")
+	}
+	n := len(circle)
+
+	var matches []*Match
+	for round := 1; round < n; round++ {
+		for i := 0; i < n/2; i++ {
+			a, b := circle[i], circle[n-1-i]
+			if a == "" || b == "" {
+				continue
+			}
+			if round%2 == 0 && i == 0 {
+				a, b = b, a
+			}
+			matches = append(matches, &Match{
+				Round:   round,
+				Players: [2]string{a, b},
+			})
+		}
+
+		// Rotate all the players but the first one.
+		last := circle[n-1]
+		copy(circle[2:], circle[1:n-1])
+		circle[1] = last
+	}
+
+	return matches, nil
+}
+
+// Next implements Format, all the matches are scheduled at the start.
+func (roundRobin) Next([]string, []*Match, *Match) []*Match {
+	return nil
+}
+
+// Standings implements Format.
+func (roundRobin) Standings(players []string, matches []*Match) []Standing {
+	standings := tally(players, matches)
+
+	seed := make(map[string]int, len(players))
+	for i, p := range players {
+		seed[p] = i
+	}
+
+	// Head-to-head points count the matches between the players tied on points only.
+	headToHead := make(map[string]float64, len(players))
+	points := make(map[string]float64, len(players))
+	for _, st := range standings {
+		points[st.Player] = st.Points
+	}
+	for _, m := range matches {
+		if m.Done && points[m.Players[0]] == points[m.Players[1]] {
+			headToHead[m.Players[0]] += m.Points(0)
+			headToHead[m.Players[1]] += m.Points(1)
+		}
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if ha, hb := headToHead[a.Player], headToHead[b.Player]; ha != hb {
+			return ha > hb
+		}
+		if a.Differential != b.Differential {
+			return a.Differential > b.Differential
+		}
+		return seed[a.Player] < seed[b.Player]
+	})
+
+	return standings
+}
@@ -0,0 +1,151 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tournament
+
+import "testing"
+
+func TestRoundRobinSchedule(t *testing.T) {
+	for n := 2; n <= 7; n++ {
+		players := []string{"a", "b", "c", "d", "e", "f", "g"}[:n]
+		tr, err := New(RoundRobin, players)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pairs := make(map[[2]string]bool)
+		rounds := make(map[int]map[string]bool)
+		for _, m := range tr.Matches() {
+			a, b := m.Players[0], m.Players[1]
+			if a == "" || b == "" {
+				t.Fatalf("%d players: match %d has a bye", n, m.ID)
+			}
+			if pairs[[2]string{a, b}] || pairs[[2]string{b, a}] {
+				t.Fatalf("%d players: %s and %s play twice", n, a, b)
+			}
+			pairs[[2]string{a, b}] = true
+
+			if rounds[m.Round] == nil {
+				rounds[m.Round] = make(map[string]bool)
+			}
+			for _, p := range m.Players {
+				if rounds[m.Round][p] {
+					t.Fatalf("%d players: %s plays twice in round %d", n, p, m.Round)
+				}
+				rounds[m.Round][p] = true
+			}
+		}
+		if want := n * (n - 1) / 2; len(pairs) != want {
+			t.Errorf("%d players: %d matches, want %d", n, len(pairs), want)
+		}
+		if want := n - 1 + n%2; len(rounds) != want {
+			t.Errorf("%d players: %d rounds, want %d", n, len(rounds), want)
+		}
+	}
+}
+
+func TestRoundRobinStandings(t *testing.T) {
+	tests := []struct {
+		name    string
+		results map[[2]string][2]int32
+		ranking []string
+	}{
+		{
+			name: "points",
+			results: map[[2]string][2]int32{
+				{"a", "b"}: {0, 2},
+				{"a", "c"}: {0, 2},
+				{"b", "c"}: {2, 0},
+			},
+			ranking: []string{"b", "c", "a"},
+		},
+		{
+			name: "head to head breaks the tie",
+			results: map[[2]string][2]int32{
+				{"a", "b"}: {0, 1},
+				{"a", "c"}: {5, 0},
+				{"a", "d"}: {5, 0},
+				{"b", "c"}: {1, 0},
+				{"b", "d"}: {0, 1},
+				{"c", "d"}: {1, 0},
+			},
+			// a and b have 2 points, b beat a despite the worse differential,
+			// c and d have 1 point, c beat d
+			ranking: []string{"b", "a", "c", "d"},
+		},
+		{
+			name: "differential",
+			results: map[[2]string][2]int32{
+				{"a", "b"}: {1, 1},
+				{"a", "c"}: {3, 1},
+				{"b", "c"}: {3, 0},
+			},
+			ranking: []string{"b", "a", "c"},
+		},
+		{
+			name: "seed",
+			results: map[[2]string][2]int32{
+				{"a", "b"}: {1, 1},
+				{"a", "c"}: {1, 1},
+				{"b", "c"}: {1, 1},
+			},
+			ranking: []string{"a", "b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := []string{"a", "b", "c"}
+			if len(tt.results) == 6 {
+				players = append(players, "d")
+			}
+			tr, err := New(RoundRobin, players)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, m := range tr.Matches() {
+				sc, ok := tt.results[m.Players]
+				if !ok {
+					rev := tt.results[[2]string{m.Players[1], m.Players[0]}]
+					sc = [2]int32{rev[1], rev[0]}
+				}
+				if err := tr.Report(m.ID, sc); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !tr.Over() {
+				t.Fatal("tournament is not over")
+			}
+			assertRanking(t, tr.Standings(), tt.ranking...)
+		})
+	}
+}
+
+func TestForfeitStandings(t *testing.T) {
+	tr, err := New(RoundRobin, []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Forfeit(1, -1); err != nil {
+		t.Fatal(err)
+	}
+	for _, st := range tr.Standings() {
+		if st.Points != 0 || st.Losses != 1 {
+			t.Errorf("standing of %s after a double forfeit = %+v, want a loss with no points", st.Player, st)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tournament
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// Swiss returns the Swiss-system tournament of the number of rounds,
+// 0 means as many rounds as needed to find a single leader: log2 of the number of players rounded up.
+// There can be no more rounds than needed for every player to play every other one.
+// Every round pairs the players with equal points, the top half of a score group against the bottom half,
+// and avoids rematches if such a pairing is found within a bounded search. The lowest ranked player who has not had a bye yet
+// gets one if the number of players is odd, a bye is a win.
+// The players are ranked by the points, then by the Buchholz score:
+// the sum of the points of the opponents, then by seed.
+func Swiss(rounds int) Format {
+	return swiss{rounds: rounds}
+}
+
+type swiss struct {
+	rounds int
+}
+
+func (swiss) Name() string { return "swiss" }
+
+// Start implements Format.
+func (s swiss) Start(players []string) ([]*Match, error) {
+	if s.rounds < 0 {
+		return nil, fmt.Errorf("number of Swiss rounds must not be negative, got %d", s.rounds)
+	}
+	if max := maxRounds(len(players)); s.rounds > max {
+		return nil, fmt.Errorf("number of Swiss rounds must be at most %d for %d players, got %d", max, len(players), s.rounds)
+	}
+	return s.pair(players, nil, 1), nil
+}
+
+// Next implements Format, it pairs the next round when the current round is finished.
+func (s swiss) Next(players []string, matches []*Match, finished *Match) []*Match {
+	round := 0
+	for _, m := range matches {
+		if m.Round > round {
+			round = m.Round
+		}
+		if !m.Done {
+			return nil
+		}
+	}
+	if round >= s.total(len(players)) {
+		return nil
+	}
+	return s.pair(players, matches, round+1)
+}
+
+// Standings implements Format.
+func (swiss) Standings(players []string, matches []*Match) []Standing {
+	standings := tally(players, matches)
+
+	index := make(map[string]int, len(players))
+	for i, st := range standings {
+		index[st.Player] = i
+	}
+
+	for _, m := range matches {
+		if bye, ok := m.bye(); ok && m.Done {
+			st := &standings[index[bye]]
+			st.Played++
+			st.Wins++
+			st.Points++
+		}
+	}
+
+	for _, m := range matches {
+		if !m.Done || m.Players[0] == "" || m.Players[1] == "" {
+			continue
+		}
+		a, b := &standings[index[m.Players[0]]], &standings[index[m.Players[1]]]
+		a.Buchholz += b.Points
+		b.Buchholz += a.Points
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.Buchholz != b.Buchholz {
+			return a.Buchholz > b.Buchholz
+		}
+		return index[a.Player] < index[b.Player]
+	})
+
+	return standings
+}
+
+// total returns the number of rounds for the number of players.
+func (s swiss) total(players int) int {
+	if s.rounds > 0 {
+		return s.rounds
+	}
+	return bits.Len(uint(players - 1))
+}
+
+// maxRounds returns the number of rounds for every player to play every other one,
+// a player has a bye in every round if the number of players is odd.
+func maxRounds(players int) int {
+	if players%2 == 1 {
+		return players
+	}
+	return players - 1
+}
+
+// maxPairingSteps bounds the search of a pairing without rematches.
+const maxPairingSteps = 10000
+
+// pair schedules the matches of the round by the results of the previous matches.
+func (s swiss) pair(players []string, matches []*Match, round int) []*Match {
+	points := make(map[string]float64, len(players))
+	for _, st := range s.Standings(players, matches) {
+		points[st.Player] = st.Points
+	}
+
+	played := make(map[[2]string]bool)
+	hadBye := make(map[string]bool)
+	for _, m := range matches {
+		if bye, ok := m.bye(); ok {
+			hadBye[bye] = true
+			continue
+		}
+		played[[2]string{m.Players[0], m.Players[1]}] = true
+		played[[2]string{m.Players[1], m.Players[0]}] = true
+	}
+
+	order := append([]string(nil), players...)
+	sort.SliceStable(order, func(i, j int) bool {
+		return points[order[i]] > points[order[j]]
+	})
+
+	var next []*Match
+	if len(order)%2 == 1 {
+		bye := len(order) - 1
+		for i := len(order) - 1; i >= 0; i-- {
+			if !hadBye[order[i]] {
+				bye = i
+				break
+			}
+		}
+		next = append(next, &Match{Round: round, Players: [2]string{order[bye], ""}})
+		order = append(order[:bye], order[bye+1:]...)
+	}
+
+	steps := maxPairingSteps
+	pairs, ok := pairSwiss(order, points, played, &steps)
+	if !ok {
+		steps = maxPairingSteps
+		pairs, _ = pairSwiss(order, points, nil, &steps)
+	}
+	for _, p := range pairs {
+		next = append(next, &Match{Round: round, Players: p})
+	}
+
+	return next
+}
+
+// pairSwiss pairs the players ordered by the points avoiding the played pairs.
+// The first player plays the middle player of the score group, the players of the lower groups if needed.
+// The search takes at most the steps, it reports false if there is no such pairing or it is not found in time.
+func pairSwiss(order []string, points map[string]float64, played map[[2]string]bool, steps *int) ([][2]string, bool) {
+	if len(order) == 0 {
+		return nil, true
+	}
+	if *steps <= 0 {
+		return nil, false
+	}
+	*steps--
+
+	a, rest := order[0], order[1:]
+
+	group := 0
+	for group < len(rest) && points[rest[group]] == points[a] {
+		group++
+	}
+	candidates := make([]int, 0, len(rest))
+	for i := group / 2; i < group; i++ {
+		candidates = append(candidates, i)
+	}
+	for i := 0; i < group/2; i++ {
+		candidates = append(candidates, i)
+	}
+	for i := group; i < len(rest); i++ {
+		candidates = append(candidates, i)
+	}
+
+	for _, i := range candidates {
+		b := rest[i]
+		if played[[2]string{a, b}] {
+			continue
+		}
+
+		remaining := make([]string, 0, len(rest)-1)
+		remaining = append(remaining, rest[:i]...)
+		remaining = append(remaining, rest[i+1:]...)
+
+		if pairs, ok := pairSwiss(remaining, points, played, steps); ok {
+			return append([][2]string{{a, b}}, pairs...), true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,170 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tournament
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSwiss(t *testing.T) {
+	tr, err := New(Swiss(3), []string{"a", "b", "c", "d"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The top half of a score group plays the bottom half.
+	report(t, tr, 1, "a", "c", 2, 0)
+	report(t, tr, 2, "b", "d", 2, 0)
+	assertPending(t, tr, 3, 4)
+
+	// The leaders play each other.
+	report(t, tr, 3, "a", "b", 2, 0)
+	report(t, tr, 4, "c", "d", 2, 0)
+
+	// Rematches are avoided.
+	report(t, tr, 5, "a", "d", 2, 0)
+	report(t, tr, 6, "b", "c", 0, 2)
+
+	if !tr.Over() {
+		t.Fatal("tournament is not over after 3 rounds")
+	}
+	assertRanking(t, tr.Standings(), "a", "c", "b", "d")
+}
+
+func TestSwissRounds(t *testing.T) {
+	tests := []struct {
+		players int
+		want    int
+	}{
+		{2, 1},
+		{3, 2},
+		{4, 2},
+		{5, 3},
+		{8, 3},
+		{9, 4},
+	}
+	for _, tt := range tests {
+		if got := Swiss(0).(swiss).total(tt.players); got != tt.want {
+			t.Errorf("total(%d) = %d, want %d", tt.players, got, tt.want)
+		}
+	}
+	if got := Swiss(5).(swiss).total(4); got != 5 {
+		t.Errorf("total(4) of 5 rounds = %d, want 5", got)
+	}
+}
+
+func TestSwissBye(t *testing.T) {
+	tr, err := New(Swiss(0), []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The lowest ranked player gets the bye.
+	if bye, ok := tr.Match(1).bye(); !ok || bye != "c" || !tr.Match(1).Done {
+		t.Fatalf("match 1 is not a bye of c: %+v", tr.Match(1))
+	}
+	report(t, tr, 2, "a", "b", 2, 1)
+
+	// The bye goes to the lowest ranked player who has not had one.
+	if bye, ok := tr.Match(3).bye(); !ok || bye != "b" {
+		t.Fatalf("match 3 is not a bye of b: %+v", tr.Match(3))
+	}
+	report(t, tr, 4, "a", "c", 1, 1)
+
+	if !tr.Over() {
+		t.Fatal("tournament is not over after 2 rounds")
+	}
+
+	standings := tr.Standings()
+	assertRanking(t, standings, "a", "c", "b")
+	for _, st := range standings {
+		if st.Played != 2 {
+			t.Errorf("%s played %d matches, want 2", st.Player, st.Played)
+		}
+	}
+	if c := standings[1]; c.Points != 1.5 || c.Wins != 1 || c.Draws != 1 {
+		t.Errorf("standing of c = %+v, want a bye and a draw", c)
+	}
+}
+
+func TestSwissBuchholz(t *testing.T) {
+	players := []string{"a", "b", "c", "d"}
+	matches := []*Match{
+		{Round: 1, Players: [2]string{"a", "c"}, Done: true, Scores: [2]int32{1, 0}, Winner: 0},
+		{Round: 1, Players: [2]string{"b", "d"}, Done: true, Scores: [2]int32{1, 0}, Winner: 0},
+		{Round: 2, Players: [2]string{"c", "d"}, Done: true, Scores: [2]int32{1, 0}, Winner: 0},
+	}
+
+	// a, b and c have 1 point, the opponents of a and c have more points than the opponent of b.
+	standings := Swiss(0).Standings(players, matches)
+	assertRanking(t, standings, "a", "c", "b", "d")
+	if standings[1].Buchholz != 1 || standings[2].Buchholz != 0 {
+		t.Errorf("Buchholz of c and b = %v and %v, want 1 and 0", standings[1].Buchholz, standings[2].Buchholz)
+	}
+}
+
+func TestSwissMaxRounds(t *testing.T) {
+	tests := []struct {
+		players int
+		rounds  int
+		ok      bool
+	}{
+		{4, 3, true},
+		{4, 4, false},
+		{5, 5, true},
+		{5, 6, false},
+	}
+	for _, tt := range tests {
+		players := []string{"a", "b", "c", "d", "e"}[:tt.players]
+		if _, err := New(Swiss(tt.rounds), players); (err == nil) != tt.ok {
+			t.Errorf("New() of %d rounds for %d players error = %v, want ok %v", tt.rounds, tt.players, err, tt.ok)
+		}
+	}
+}
+
+func TestPairSwissBounded(t *testing.T) {
+	// The odd group has played all the others, so it can only be paired within itself
+	// and there is no pairing without rematches, which takes exponential time to find out.
+	var order []string
+	played := make(map[[2]string]bool)
+	for i := 0; i < 40; i++ {
+		order = append(order, fmt.Sprintf("p%02d", i))
+	}
+	for _, a := range order[:21] {
+		for _, b := range order[21:] {
+			played[[2]string{a, b}] = true
+			played[[2]string{b, a}] = true
+		}
+	}
+	points := make(map[string]float64)
+
+	steps := maxPairingSteps
+	if _, ok := pairSwiss(order, points, played, &steps); ok {
+		t.Fatal("pairSwiss() found a pairing without rematches")
+	}
+	if steps != 0 {
+		t.Errorf("pairSwiss() stopped with %d steps left, want the bound exhausted", steps)
+	}
+
+	steps = maxPairingSteps
+	pairs, ok := pairSwiss(order, points, nil, &steps)
+	if !ok || len(pairs) != 20 {
+		t.Errorf("pairSwiss() with rematches = %v, %v, want 20 pairs", pairs, ok)
+	}
+}
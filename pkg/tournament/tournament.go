@@ -0,0 +1,450 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package tournament schedules the matches of tournaments and ranks their players
+// independently of the way the matches are played.
+package tournament
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Match is a game of two players in a tournament.
+type Match struct {
+	// ID is the 1-based match number in the tournament.
+	ID int
+
+	// Round is the 1-based tournament round of the match.
+	Round int
+
+	// Bracket is the bracket of an elimination tournament the match belongs to.
+	Bracket Bracket
+
+	// Players are the IDs of the players,
+	// an empty ID means a bye or a player who is not known yet.
+	Players [2]string
+
+	// Sources tell where the players who are not known at the start come from.
+	Sources [2]*Source
+
+	// Done reports whether the match is finished.
+	Done bool
+
+	// Scores are the players' final scores.
+	Scores [2]int32
+
+	// Winner is the index of the winner in Players, -1 if no one won.
+	Winner int
+
+	// Forfeit reports whether the match was not played: the winner, if any, won by default.
+	Forfeit bool
+}
+
+// Source is a match which decides a player of another match.
+type Source struct {
+	// Match is the ID of the deciding match.
+	Match int
+
+	// Loser means the loser of the deciding match plays, otherwise the winner does.
+	Loser bool
+}
+
+// Bracket is a bracket of an elimination tournament.
+type Bracket int
+
+// Brackets of the matches.
+const (
+	// NoBracket is the bracket of the tournaments with no elimination.
+	NoBracket Bracket = iota
+	// WinnersBracket is the bracket of the players who have not lost yet.
+	WinnersBracket
+	// LosersBracket is the bracket of the players who have lost once in a double elimination.
+	LosersBracket
+	// GrandFinal is the match of the winners of both brackets in a double elimination.
+	GrandFinal
+)
+
+// Loser returns the index of the loser in Players, -1 if no one lost the match.
+// Both players lose a forfeit with no winner.
+func (m *Match) Loser() int {
+	if m.Winner < 0 {
+		return -1
+	}
+	return 1 - m.Winner
+}
+
+// Points returns the tournament points of the player by the finished match:
+// 1 for a win, 0.5 for a draw and 0 for a loss.
+func (m *Match) Points(i int) float64 {
+	switch {
+	case m.Winner == i:
+		return 1
+	case m.Winner < 0 && !m.Forfeit:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// bye returns the player who has a bye in the match.
+// It reports false if the match is played by two players.
+func (m *Match) bye() (string, bool) {
+	switch {
+	case m.Players[0] != "" && m.Players[1] == "" && m.Sources[1] == nil:
+		return m.Players[0], true
+	case m.Players[1] != "" && m.Players[0] == "" && m.Sources[0] == nil:
+		return m.Players[1], true
+	}
+	return "", false
+}
+
+// Format schedules the matches of a tournament and ranks its players.
+type Format interface {
+	// Name returns the format name.
+	Name() string
+
+	// Start schedules the first matches of the players ordered by seed, the best first.
+	// The matches get IDs from 1 in the returned order, the sources refer to them by the IDs.
+	Start(players []string) ([]*Match, error)
+
+	// Next schedules the matches which can be played after the match has finished.
+	// Matches are all the matches of the tournament including the finished one.
+	Next(players []string, matches []*Match, finished *Match) []*Match
+
+	// Standings ranks the players by the finished matches.
+	Standings(players []string, matches []*Match) []Standing
+}
+
+// Standing is a player's tournament result.
+type Standing struct {
+	// Player is the player ID.
+	Player string
+
+	// Played is the number of finished matches.
+	Played int
+
+	// Wins is the number of won matches.
+	Wins int
+
+	// Draws is the number of drawn matches.
+	Draws int
+
+	// Losses is the number of lost matches.
+	Losses int
+
+	// Points are the tournament points: 1 for a win and 0.5 for a draw.
+	Points float64
+
+	// Differential is the number of won rounds minus the number of lost rounds.
+	Differential int32
+
+	// Buchholz is the sum of the points of the opponents, if the format uses it as a tiebreak.
+	Buchholz float64
+}
+
+var formats = map[string]Format{
+	RoundRobin.Name():        RoundRobin,
+	SingleElimination.Name(): SingleElimination,
+	DoubleElimination.Name(): DoubleElimination,
+	Swiss(0).Name():          Swiss(0),
+}
+
+// LookupFormat returns the built-in tournament format by its name.
+func LookupFormat(name string) (Format, error) {
+	f, ok := formats[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown tournament format %q, available are %v", name, FormatNames())
+	}
+	return f, nil
+}
+
+// FormatNames returns the sorted names of the built-in tournament formats.
+func FormatNames() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Tournament tracks the matches of a tournament.
+// Tournament is not safe for concurrent use.
+type Tournament struct {
+	format  Format
+	players []string
+	matches []*Match
+}
+
+// New starts a tournament of the players ordered by seed, the best first.
+func New(format Format, players []string) (*Tournament, error) {
+	if len(players) < 2 {
+		return nil, fmt.Errorf("tournament needs at least 2 players, got %d", len(players))
+	}
+	seen := make(map[string]bool, len(players))
+	for _, p := range players {
+		if seen[p] {
+			return nil, fmt.Errorf("player %q is registered twice", p)
+		}
+		seen[p] = true
+	}
+
+	t := &Tournament{
+		format:  format,
+		players: append([]string(nil), players...),
+	}
+
+	matches, err := format.Start(t.players)
+	if err != nil {
+		return nil, err
+	}
+	t.add(matches)
+
+	return t, nil
+}
+
+// Format returns the tournament format.
+func (t *Tournament) Format() Format {
+	return t.format
+}
+
+// Players returns the players ordered by seed.
+func (t *Tournament) Players() []string {
+	return t.players
+}
+
+// Matches returns all the scheduled matches in the order of scheduling.
+func (t *Tournament) Matches() []*Match {
+	return t.matches
+}
+
+// Match returns the match by its ID or nil.
+func (t *Tournament) Match(id int) *Match {
+	if id < 1 || id > len(t.matches) {
+		return nil
+	}
+	return t.matches[id-1]
+}
+
+// Pending returns the unfinished matches whose players are known.
+func (t *Tournament) Pending() []*Match {
+	var pending []*Match
+	for _, m := range t.matches {
+		if !m.Done && t.decided(m) {
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
+
+// Over reports whether all the matches are finished.
+func (t *Tournament) Over() bool {
+	for _, m := range t.matches {
+		if !m.Done {
+			return false
+		}
+	}
+	return true
+}
+
+// Report finishes the match with the players' scores and schedules the next matches.
+// A draw in an elimination match or in a match which decides a player of another match
+// is won by the better seed.
+func (t *Tournament) Report(id int, scores [2]int32) error {
+	m, err := t.pending(id)
+	if err != nil {
+		return err
+	}
+
+	m.Scores = scores
+	m.Winner = -1
+	switch {
+	case scores[0] > scores[1]:
+		m.Winner = 0
+	case scores[1] > scores[0]:
+		m.Winner = 1
+	case m.Bracket != NoBracket || t.deciding(m):
+		m.Winner = 0
+		if t.seed(m.Players[1]) < t.seed(m.Players[0]) {
+			m.Winner = 1
+		}
+	}
+
+	t.finish(m)
+	return nil
+}
+
+// Forfeit finishes the match which was not played, the winner is the index of the player
+// who wins by default or -1 if both players lose.
+func (t *Tournament) Forfeit(id int, winner int) error {
+	m, err := t.pending(id)
+	if err != nil {
+		return err
+	}
+	if winner < -1 || winner > 1 {
+		return fmt.Errorf("invalid winner %d of match %d", winner, id)
+	}
+
+	m.Winner = winner
+	m.Forfeit = true
+
+	t.finish(m)
+	return nil
+}
+
+// Standings ranks the players by the finished matches.
+func (t *Tournament) Standings() []Standing {
+	return t.format.Standings(t.players, t.matches)
+}
+
+// pending returns the unfinished match by its ID.
+func (t *Tournament) pending(id int) (*Match, error) {
+	m := t.Match(id)
+	if m == nil {
+		return nil, fmt.Errorf("match %d is not found", id)
+	}
+	if m.Done {
+		return nil, fmt.Errorf("match %d is already finished", id)
+	}
+	return m, nil
+}
+
+// finish marks the match as finished and schedules the next matches.
+func (t *Tournament) finish(m *Match) {
+	m.Done = true
+	t.add(t.format.Next(t.players, t.matches, m))
+}
+
+// add numbers the new matches, adds them to the tournament and advances the players.
+func (t *Tournament) add(matches []*Match) {
+	for _, m := range matches {
+		m.ID = len(t.matches) + 1
+		t.matches = append(t.matches, m)
+	}
+	t.advance()
+}
+
+// advance fills in the players decided by the finished matches.
+// A player with a bye wins the match by default.
+func (t *Tournament) advance() {
+	for changed := true; changed; {
+		changed = false
+		for _, m := range t.matches {
+			if m.Done || !t.decided(m) {
+				continue
+			}
+			for i, src := range m.Sources {
+				if src != nil {
+					m.Players[i] = t.player(src)
+				}
+			}
+			if m.Players[0] == "" || m.Players[1] == "" {
+				m.Winner = -1
+				if m.Players[0] != "" {
+					m.Winner = 0
+				} else if m.Players[1] != "" {
+					m.Winner = 1
+				}
+				m.Forfeit = true
+				m.Done = true
+				changed = true
+			}
+		}
+	}
+}
+
+// decided reports whether the matches deciding the players of the match are finished.
+func (t *Tournament) decided(m *Match) bool {
+	for _, src := range m.Sources {
+		if src != nil && !t.Match(src.Match).Done {
+			return false
+		}
+	}
+	return true
+}
+
+// player returns the player decided by the finished source match, empty if there is no such player.
+func (t *Tournament) player(src *Source) string {
+	m := t.Match(src.Match)
+	i := m.Winner
+	if src.Loser {
+		i = m.Loser()
+	}
+	if i < 0 {
+		return ""
+	}
+	return m.Players[i]
+}
+
+// deciding reports whether the match decides a player of another match.
+func (t *Tournament) deciding(m *Match) bool {
+	for _, o := range t.matches {
+		for _, src := range o.Sources {
+			if src != nil && src.Match == m.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// seed returns the 0-based seed of the player.
+func (t *Tournament) seed(player string) int {
+	for i, p := range t.players {
+		if p == player {
+			return i
+		}
+	}
+	return len(t.players)
+}
+
+// tally sums up the players' results of the finished matches in the order of the players.
+func tally(players []string, matches []*Match) []Standing {
+	standings := make([]Standing, len(players))
+	index := make(map[string]int, len(players))
+	for i, p := range players {
+		standings[i].Player = p
+		index[p] = i
+	}
+
+	for _, m := range matches {
+		if !m.Done || m.Players[0] == "" || m.Players[1] == "" {
+			continue
+		}
+		for side, p := range m.Players {
+			i, ok := index[p]
+			if !ok {
+				continue
+			}
+			st := &standings[i]
+			st.Played++
+			st.Points += m.Points(side)
+			st.Differential += m.Scores[side] - m.Scores[1-side]
+			switch {
+			case m.Winner == side:
+				st.Wins++
+			case m.Winner < 0 && !m.Forfeit:
+				st.Draws++
+			default:
+				st.Losses++
+			}
+		}
+	}
+
+	return standings
+}
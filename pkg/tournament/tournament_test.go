@@ -0,0 +1,111 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tournament
+
+import "testing"
+
+func TestNew(t *testing.T) {
+	if _, err := New(RoundRobin, []string{"a"}); err == nil {
+		t.Error("New() of 1 player = nil error")
+	}
+	if _, err := New(RoundRobin, []string{"a", "b", "a"}); err == nil {
+		t.Error("New() of a duplicate player = nil error")
+	}
+	if _, err := New(Swiss(-1), []string{"a", "b"}); err == nil {
+		t.Error("New() of negative Swiss rounds = nil error")
+	}
+}
+
+func TestReport(t *testing.T) {
+	tr, err := New(RoundRobin, []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Report(2, [2]int32{1, 0}); err == nil {
+		t.Error("Report() of an unknown match = nil error")
+	}
+	if err := tr.Report(1, [2]int32{1, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Report(1, [2]int32{1, 0}); err == nil {
+		t.Error("Report() of a finished match = nil error")
+	}
+}
+
+func TestLookupFormat(t *testing.T) {
+	for _, name := range FormatNames() {
+		f, err := LookupFormat(name)
+		if err != nil {
+			t.Fatalf("LookupFormat(%s) = %v", name, err)
+		}
+		if f.Name() != name {
+			t.Errorf("LookupFormat(%s) returned %s", name, f.Name())
+		}
+	}
+	if _, err := LookupFormat("nope"); err == nil {
+		t.Error("LookupFormat(nope) = nil error")
+	}
+}
+
+// report finishes the match checking its players.
+func report(t *testing.T, tr *Tournament, id int, a, b string, sa, sb int32) {
+	t.Helper()
+	m := tr.Match(id)
+	if m == nil {
+		t.Fatalf("match %d is not found", id)
+	}
+	if m.Players != [2]string{a, b} {
+		t.Fatalf("match %d is played by %v, want [%s %s]", id, m.Players, a, b)
+	}
+	if err := tr.Report(id, [2]int32{sa, sb}); err != nil {
+		t.Fatalf("Report(%d) = %v", id, err)
+	}
+}
+
+func assertPending(t *testing.T, tr *Tournament, ids ...int) {
+	t.Helper()
+	pending := tr.Pending()
+	got := make([]int, len(pending))
+	for i, m := range pending {
+		got[i] = m.ID
+	}
+	if len(got) != len(ids) {
+		t.Fatalf("pending matches are %v, want %v", got, ids)
+	}
+	for i := range ids {
+		if got[i] != ids[i] {
+			t.Fatalf("pending matches are %v, want %v", got, ids)
+		}
+	}
+}
+
+func assertRanking(t *testing.T, standings []Standing, players ...string) {
+	t.Helper()
+	got := make([]string, len(standings))
+	for i, st := range standings {
+		got[i] = st.Player
+	}
+	if len(got) != len(players) {
+		t.Fatalf("ranking is %v, want %v", got, players)
+	}
+	for i := range players {
+		if got[i] != players[i] {
+			t.Fatalf("ranking is %v, want %v", got, players)
+		}
+	}
+}
@@ -0,0 +1,534 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/account"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+	"github.com/movaua/rock-paper-scissors/pkg/token"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// newTokenSigner creates the signer of the session tokens set by the config.
+func newTokenSigner() (*token.Signer, error) {
+	ttl := viper.GetDuration("token-ttl")
+	if ttl <= 0 {
+		return nil, fmt.Errorf("token lifetime must be positive, got %v", ttl)
+	}
+
+	secret := []byte(viper.GetString("token-secret"))
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("cannot generate token secret: %w", err)
+		}
+		fmt.Println("token secret is not set, session tokens are valid until the server restarts")
+	}
+
+	return token.NewSigner(secret, ttl), nil
+}
+
+// publicMethods are the methods which can be called without a session token.
+var publicMethods = map[string]bool{
+	"/rps.Gamer/Auth":            true,
+	"/rps.Gamer/Challenge":       true,
+	"/rps.Gamer/Rulesets":        true,
+	"/rps.Gamer/ListRooms":       true,
+	"/rps.Gamer/GetPlayerStats":  true,
+	"/rps.Gamer/Leaderboard":     true,
+	"/rps.Gamer/GetTournament":   true,
+	"/rps.Gamer/ListTournaments": true,
+	"/rps.Gamer/GetBracket":      true,
+}
+
+// playerKey is the context key of the authenticated player ID.
+type playerKey struct{}
+
+// challengeTimeout is the time to sign a challenge.
+const challengeTimeout = time.Minute
+
+// botInfix joins the name of a player and the number of the player's bot in the bot name.
+// The bots a player registers with a client certificate are named so
+// not to take the names of the other certificate players.
+const botInfix = "-bot-"
+
+// challenge is a challenge issued to a player to sign.
+type challenge struct {
+	accountID string
+	data      []byte
+	expires   time.Time
+}
+
+// Auth registers a new player with the name or, if the player asks to reconnect,
+// returns the player registered with the name before.
+// A player can register with a password or with a public key or both,
+// and then has to prove one of them to reconnect.
+// A player registers a bot with the player's session token, the bot is owned by the player.
+// A player with a verified client certificate is the player named by the certificate
+// or one of the bots the player owns.
+func (s *gameServer) Auth(ctx context.Context, r *pb.AuthRequest) (*pb.AuthResponse, error) {
+	if certName, ok := clientCertName(ctx); ok {
+		name := r.GetName()
+		if name == "" {
+			name = certName
+		}
+		a, err := s.certAccount(certName)
+		if err != nil {
+			return nil, err
+		}
+		if name != certName {
+			if a, err = s.botAccount(a, r); err != nil {
+				return nil, err
+			}
+		}
+		return s.signIn(a)
+	}
+
+	name := r.GetName()
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "player name is empty")
+	}
+
+	a, err := s.accounts.Lookup(name)
+	switch {
+	case err == nil:
+		if !r.GetReconnect() {
+			return nil, status.Errorf(codes.AlreadyExists, "player name %q is taken", name)
+		}
+		if err := s.checkCredentials(a, r); err != nil {
+			return nil, err
+		}
+		if r.GetOwnerToken() != "" {
+			owner, err := s.tokenOwner(r.GetOwnerToken())
+			if err != nil {
+				return nil, err
+			}
+			if a.Owner != owner {
+				return nil, status.Errorf(codes.PermissionDenied, "player %q is not a bot of the owner", a.Name)
+			}
+		}
+	case errors.Is(err, account.ErrNotFound):
+		owner, err := s.tokenOwner(r.GetOwnerToken())
+		if err != nil {
+			return nil, err
+		}
+		if a, err = s.registerAccount(r, owner); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return s.signIn(a)
+}
+
+// signIn authenticates the player of the account and issues a session token.
+func (s *gameServer) signIn(a account.Account) (*pb.AuthResponse, error) {
+	player := s.loadPlayer(a)
+
+	tok, err := s.tokens.Issue(player.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AuthResponse{
+		Id:     player.Id,
+		Player: player,
+		Token:  tok,
+	}, nil
+}
+
+// loadPlayer makes the player of the account authenticated and returns the player.
+func (s *gameServer) loadPlayer(a account.Account) *pb.Player {
+	rating := s.rating(a.ID)
+
+	player := &pb.Player{
+		Name:            a.Name,
+		Id:              a.ID,
+		Rating:          rating.Value,
+		RatingDeviation: rating.Deviation,
+	}
+
+	s.mu.Lock()
+	s.players[player.Id] = player
+	s.mu.Unlock()
+
+	return player
+}
+
+// certAccount returns the account of the player named by a verified client certificate,
+// the account is registered on the first call.
+func (s *gameServer) certAccount(name string) (account.Account, error) {
+	a, err := s.accounts.Lookup(name)
+	if err == nil {
+		return a, nil
+	}
+	if !errors.Is(err, account.ErrNotFound) {
+		return account.Account{}, status.Error(codes.Internal, err.Error())
+	}
+
+	id, err := account.NewID()
+	if err != nil {
+		return account.Account{}, status.Error(codes.Internal, err.Error())
+	}
+	a = account.Account{
+		ID:      id,
+		Name:    name,
+		Created: time.Now(),
+	}
+	if err := s.accounts.Create(a); err != nil {
+		if errors.Is(err, account.ErrNameTaken) {
+			return s.certAccount(name)
+		}
+		return account.Account{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return a, nil
+}
+
+// botAccount returns the account of the bot of the certificate player named by the request,
+// the bot is registered with the credentials of the request if it is not registered yet.
+// The certificate proves the ownership of the bot.
+func (s *gameServer) botAccount(owner account.Account, r *pb.AuthRequest) (account.Account, error) {
+	a, err := s.accounts.Lookup(r.GetName())
+	switch {
+	case err == nil:
+		if a.Owner != owner.ID {
+			return account.Account{}, status.Errorf(codes.PermissionDenied, "player %q is not a bot of player %q", a.Name, owner.Name)
+		}
+		return a, nil
+	case errors.Is(err, account.ErrNotFound):
+		prefix := owner.Name + botInfix
+		if len(r.GetName()) <= len(prefix) || !strings.HasPrefix(r.GetName(), prefix) {
+			return account.Account{}, status.Errorf(codes.PermissionDenied, "client certificate is issued to player %q, whose bots are named %s<n>", owner.Name, prefix)
+		}
+		return s.registerAccount(r, owner.ID)
+	default:
+		return account.Account{}, status.Error(codes.Internal, err.Error())
+	}
+}
+
+// tokenOwner returns the ID of the player the owner token is issued to, empty if there is no token.
+func (s *gameServer) tokenOwner(tok string) (string, error) {
+	if tok == "" {
+		return "", nil
+	}
+	owner, err := s.tokens.Verify(tok)
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "owner token is invalid: %v", err)
+	}
+	return owner, nil
+}
+
+// Challenge returns a random challenge for the player to sign with the player's key.
+func (s *gameServer) Challenge(ctx context.Context, r *pb.ChallengeRequest) (*pb.ChallengeResponse, error) {
+	a, err := s.accounts.Lookup(r.GetName())
+	if errors.Is(err, account.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "player %q is not found", r.GetName())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(a.PublicKey) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "player %q has no public key", a.Name)
+	}
+
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	id, err := account.NewID()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	now := time.Now()
+
+	s.mu.Lock()
+	for cid, c := range s.challenges {
+		if !now.Before(c.expires) {
+			delete(s.challenges, cid)
+		}
+	}
+	s.challenges[id] = challenge{
+		accountID: a.ID,
+		data:      data,
+		expires:   now.Add(challengeTimeout),
+	}
+	s.mu.Unlock()
+
+	return &pb.ChallengeResponse{
+		Challenge:      data,
+		TimeoutSeconds: int32(challengeTimeout / time.Second),
+		ChallengeId:    id,
+	}, nil
+}
+
+// registerAccount creates the account of a new player with the credentials of the request,
+// the player is a bot if the owner is set.
+func (s *gameServer) registerAccount(r *pb.AuthRequest, owner string) (account.Account, error) {
+	id, err := account.NewID()
+	if err != nil {
+		return account.Account{}, status.Error(codes.Internal, err.Error())
+	}
+	a := account.Account{
+		ID:      id,
+		Name:    r.GetName(),
+		Created: time.Now(),
+		Owner:   owner,
+	}
+
+	if r.GetPassword() != "" {
+		if a.PasswordHash, err = account.HashPassword(r.GetPassword()); err != nil {
+			return account.Account{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if len(r.GetPublicKey()) > 0 {
+		if err := account.CheckPublicKey(r.GetPublicKey()); err != nil {
+			return account.Account{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		a.PublicKey = r.GetPublicKey()
+	}
+	if s.requireCredentials && !a.Protected() {
+		return account.Account{}, status.Error(codes.InvalidArgument, "password or public key is required to register")
+	}
+
+	if err := s.accounts.Create(a); err != nil {
+		if errors.Is(err, account.ErrNameTaken) {
+			return account.Account{}, status.Errorf(codes.AlreadyExists, "player name %q is taken", a.Name)
+		}
+		return account.Account{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return a, nil
+}
+
+// checkCredentials checks that the request proves the password or the key of the account.
+// The signed challenge is used up by the check.
+func (s *gameServer) checkCredentials(a account.Account, r *pb.AuthRequest) error {
+	if !a.Protected() {
+		return nil
+	}
+
+	if r.GetPassword() != "" && a.CheckPassword(r.GetPassword()) {
+		return nil
+	}
+
+	if len(r.GetSignature()) > 0 {
+		s.mu.Lock()
+		c, ok := s.challenges[r.GetChallengeId()]
+		if ok && c.accountID == a.ID {
+			delete(s.challenges, r.GetChallengeId())
+		}
+		s.mu.Unlock()
+
+		if ok && c.accountID == a.ID && time.Now().Before(c.expires) && a.CheckSignature(c.data, r.GetSignature()) {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.Unauthenticated, "invalid credentials of player %q", a.Name)
+}
+
+// playerFromContext returns the ID of the player authenticated by the session token of the call.
+func playerFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(playerKey{}).(string)
+	return id
+}
+
+// authUnary authenticates the player by the session token of a unary call
+// and binds the player_id of the request to the player.
+func (s *gameServer) authUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	playerID, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := bindPlayer(req, playerID); err != nil {
+		return nil, err
+	}
+
+	return handler(context.WithValue(ctx, playerKey{}, playerID), req)
+}
+
+// authStream authenticates the player by the session token of a streaming call
+// and binds the player_id of every received message to the player.
+func (s *gameServer) authStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if publicMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+
+	playerID, err := s.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &playerStream{
+		ServerStream: ss,
+		ctx:          context.WithValue(ss.Context(), playerKey{}, playerID),
+		playerID:     playerID,
+	})
+}
+
+// authenticate returns the ID of the player by the bearer token in the authorization metadata
+// or by the verified client certificate if there is no token.
+// With a client certificate the token must be issued to the player named by the certificate
+// or to one of the bots the player owns.
+func (s *gameServer) authenticate(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+
+	certName, cert := clientCertName(ctx)
+	if cert && len(values) == 0 {
+		a, err := s.certAccount(certName)
+		if err != nil {
+			return "", err
+		}
+
+		s.mu.Lock()
+		player := s.findPlayer(a.ID)
+		s.mu.Unlock()
+		if player == nil {
+			s.loadPlayer(a)
+		}
+
+		return a.ID, nil
+	}
+
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "session token is missing")
+	}
+
+	const prefix = "Bearer "
+	if !strings.HasPrefix(values[0], prefix) {
+		return "", status.Error(codes.Unauthenticated, "authorization is not a bearer token")
+	}
+
+	playerID, err := s.tokens.Verify(strings.TrimPrefix(values[0], prefix))
+	if errors.Is(err, token.ErrExpired) {
+		return "", status.Error(codes.Unauthenticated, "session token is expired, authenticate again")
+	}
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "session token is invalid")
+	}
+
+	s.mu.Lock()
+	player := s.findPlayer(playerID)
+	s.mu.Unlock()
+	if player == nil {
+		// The token was issued before the server restarted.
+		a, err := s.accounts.Get(playerID)
+		if errors.Is(err, account.ErrNotFound) {
+			return "", status.Errorf(codes.Unauthenticated, "player %q is not found, authenticate again", playerID)
+		}
+		if err != nil {
+			return "", status.Error(codes.Internal, err.Error())
+		}
+		player = s.loadPlayer(a)
+	}
+
+	if cert && player.GetName() != certName {
+		owned, err := s.ownedBy(playerID, certName)
+		if err != nil {
+			return "", err
+		}
+		if !owned {
+			return "", status.Errorf(codes.PermissionDenied, "client certificate is issued to player %q", certName)
+		}
+	}
+
+	return playerID, nil
+}
+
+// ownedBy reports whether the player is a bot of the player with the name.
+func (s *gameServer) ownedBy(playerID, ownerName string) (bool, error) {
+	a, err := s.accounts.Get(playerID)
+	if err != nil {
+		return false, status.Error(codes.Internal, err.Error())
+	}
+	if a.Owner == "" {
+		return false, nil
+	}
+	owner, err := s.accounts.Lookup(ownerName)
+	if errors.Is(err, account.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, status.Error(codes.Internal, err.Error())
+	}
+	return a.Owner == owner.ID, nil
+}
+
+// playerStream is a server stream of an authenticated player.
+type playerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	playerID string
+}
+
+// Context returns the stream context with the authenticated player.
+func (s *playerStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg receives the message and binds its player_id to the authenticated player.
+func (s *playerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return bindPlayer(m, s.playerID)
+}
+
+// bindPlayer sets the player_id field of the message to the authenticated player.
+// It fails if the message names another player.
+func bindPlayer(m interface{}, playerID string) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	r := msg.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName("player_id")
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return nil
+	}
+
+	if got := r.Get(fd).String(); got != "" && got != playerID {
+		return status.Errorf(codes.PermissionDenied, "player %q cannot act as player %q", playerID, got)
+	}
+	r.Set(fd, protoreflect.ValueOfString(playerID))
+
+	return nil
+}
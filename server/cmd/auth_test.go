@@ -0,0 +1,243 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthPassword(t *testing.T) {
+	s := newTestGameServer(t)
+	ctx := context.Background()
+
+	res, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.AuthRequest
+		code codes.Code
+	}{
+		{name: "taken", req: &pb.AuthRequest{Name: "alice", Password: "secret"}, code: codes.AlreadyExists},
+		{name: "right password", req: &pb.AuthRequest{Name: "alice", Password: "secret", Reconnect: true}, code: codes.OK},
+		{name: "wrong password", req: &pb.AuthRequest{Name: "alice", Password: "guess", Reconnect: true}, code: codes.Unauthenticated},
+		{name: "no password", req: &pb.AuthRequest{Name: "alice", Reconnect: true}, code: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Auth(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Fatalf("Auth() = %v, want %v", err, tt.code)
+			}
+			if err == nil && got.GetId() != res.GetId() {
+				t.Errorf("Auth() = player %q, want %q", got.GetId(), res.GetId())
+			}
+		})
+	}
+}
+
+func TestAuthChallenge(t *testing.T) {
+	s := newTestGameServer(t)
+	ctx := context.Background()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice", PublicKey: pub}); err != nil {
+		t.Fatal(err)
+	}
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// reconnect signs a new challenge with the key and reconnects alice
+	reconnect := func(key ed25519.PrivateKey, prepare func(id string)) (*pb.ChallengeResponse, error) {
+		ch, err := s.Challenge(ctx, &pb.ChallengeRequest{Name: "alice"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if prepare != nil {
+			prepare(ch.GetChallengeId())
+		}
+		_, err = s.Auth(ctx, &pb.AuthRequest{
+			Name:        "alice",
+			Reconnect:   true,
+			ChallengeId: ch.GetChallengeId(),
+			Signature:   ed25519.Sign(key, ch.GetChallenge()),
+		})
+		return ch, err
+	}
+
+	ch, err := reconnect(priv, nil)
+	if err != nil {
+		t.Fatalf("Auth() with a signed challenge = %v", err)
+	}
+	_, err = s.Auth(ctx, &pb.AuthRequest{
+		Name:        "alice",
+		Reconnect:   true,
+		ChallengeId: ch.GetChallengeId(),
+		Signature:   ed25519.Sign(priv, ch.GetChallenge()),
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Auth() with a reused challenge = %v, want %v", err, codes.Unauthenticated)
+	}
+
+	if _, err := reconnect(otherKey, nil); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Auth() with a signature of another key = %v, want %v", err, codes.Unauthenticated)
+	}
+
+	expire := func(id string) {
+		s.mu.Lock()
+		c := s.challenges[id]
+		c.expires = time.Now().Add(-time.Second)
+		s.challenges[id] = c
+		s.mu.Unlock()
+	}
+	if _, err := reconnect(priv, expire); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Auth() with an expired challenge = %v, want %v", err, codes.Unauthenticated)
+	}
+
+	if _, err := s.Challenge(ctx, &pb.ChallengeRequest{Name: "bob"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Challenge() of an unknown player = %v, want %v", err, codes.NotFound)
+	}
+}
+
+func TestAuthRequireCredentials(t *testing.T) {
+	s := newTestGameServer(t, func(cfg *serverConfig) {
+		cfg.requireCredentials = true
+	})
+	ctx := context.Background()
+
+	if _, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Auth() with a bare name = %v, want %v", err, codes.InvalidArgument)
+	}
+	if _, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice", PublicKey: []byte("short")}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Auth() with an invalid key = %v, want %v", err, codes.InvalidArgument)
+	}
+	if _, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice", Password: "secret"}); err != nil {
+		t.Errorf("Auth() with a password = %v", err)
+	}
+}
+
+func TestAuthBot(t *testing.T) {
+	s := newTestGameServer(t, func(cfg *serverConfig) {
+		cfg.requireCredentials = true
+	})
+	ctx := context.Background()
+
+	owner, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice-bot-1", Reconnect: true, PublicKey: pub, OwnerToken: owner.GetToken()})
+	if err != nil {
+		t.Fatalf("Auth() of a bot = %v", err)
+	}
+	if a, err := s.accounts.Get(b.GetId()); err != nil || a.Owner != owner.GetId() || !a.Protected() {
+		t.Errorf("bot account = %+v, %v, want a protected account owned by alice", a, err)
+	}
+
+	ch, err := s.Challenge(ctx, &pb.ChallengeRequest{Name: "alice-bot-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Auth(ctx, &pb.AuthRequest{
+		Name:        "alice-bot-1",
+		Reconnect:   true,
+		ChallengeId: ch.GetChallengeId(),
+		Signature:   ed25519.Sign(priv, ch.GetChallenge()),
+		OwnerToken:  owner.GetToken(),
+	}); err != nil {
+		t.Errorf("Auth() of the bot again = %v", err)
+	}
+
+	// bob registered his account named like a bot of alice
+	if _, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice-bot-2", Password: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice-bot-2", Reconnect: true, Password: "bob", OwnerToken: owner.GetToken()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Auth() of a bot of another owner = %v, want %v", err, codes.PermissionDenied)
+	}
+
+	if _, err := s.Auth(ctx, &pb.AuthRequest{Name: "alice-bot-3", PublicKey: pub, OwnerToken: "forged"}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Auth() with an invalid owner token = %v, want %v", err, codes.Unauthenticated)
+	}
+}
+
+func TestCertBots(t *testing.T) {
+	s := newTestGameServer(t)
+
+	alice, err := s.certAccount("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bot, err := s.botAccount(alice, &pb.AuthRequest{Name: "alice-bot-1"})
+	if err != nil {
+		t.Fatalf("botAccount() of a new bot = %v", err)
+	}
+	if again, err := s.botAccount(alice, &pb.AuthRequest{Name: "alice-bot-1"}); err != nil || again.ID != bot.ID {
+		t.Errorf("botAccount() again = %+v, %v, want the bot %q", again, err, bot.ID)
+	}
+
+	// a bot named after alice registered by someone else
+	if _, err := s.Auth(context.Background(), &pb.AuthRequest{Name: "alice-bot-2"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"alice-bot-2", "bob", "alice-bot-"} {
+		if _, err := s.botAccount(alice, &pb.AuthRequest{Name: name}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("botAccount(%s) = %v, want %v", name, err, codes.PermissionDenied)
+		}
+	}
+
+	other, err := s.accounts.Lookup("alice-bot-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		player, owner string
+		want          bool
+	}{
+		{bot.ID, "alice", true},
+		{bot.ID, "bob", false},
+		{other.ID, "alice", false},
+		{alice.ID, "alice-bot-1", false},
+	}
+	for _, tt := range tests {
+		if got, err := s.ownedBy(tt.player, tt.owner); err != nil || got != tt.want {
+			t.Errorf("ownedBy(%s, %s) = %v, %v, want %v", tt.player, tt.owner, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+	"github.com/movaua/rock-paper-scissors/pkg/stats"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultPageSize is the leaderboard page size if a client does not set it.
+	defaultPageSize = 10
+
+	// maxPageSize is the maximum leaderboard page size.
+	maxPageSize = 100
+)
+
+// Leaderboard returns a page of the players ranked by their results in the completed games.
+// The page token is the offset of the page.
+func (s *gameServer) Leaderboard(ctx context.Context, req *pb.LeaderboardRequest) (*pb.LeaderboardResponse, error) {
+	f := stats.Filter{
+		Ruleset: req.GetRuleset(),
+	}
+	switch req.GetPeriod() {
+	case pb.EnumPeriod_AllTime:
+	case pb.EnumPeriod_Daily:
+		f.Since = time.Now().Add(-24 * time.Hour)
+	case pb.EnumPeriod_Weekly:
+		f.Since = time.Now().Add(-7 * 24 * time.Hour)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "period %v is not supported", req.GetPeriod())
+	}
+
+	var order stats.Order
+	switch req.GetSort() {
+	case pb.EnumLeaderboardSort_ByRating:
+		order = stats.ByRating
+	case pb.EnumLeaderboardSort_ByWins:
+		order = stats.ByWins
+	case pb.EnumLeaderboardSort_ByWinRate:
+		order = stats.ByWinRate
+	case pb.EnumLeaderboardSort_ByGames:
+		order = stats.ByGames
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "sort %v is not supported", req.GetSort())
+	}
+
+	size := int(req.GetPageSize())
+	switch {
+	case size < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative, got %d", size)
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	offset := 0
+	if token := req.GetPageToken(); token != "" {
+		var err error
+		offset, err = strconv.Atoi(token)
+		if err != nil || offset < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "page token %q is invalid", token)
+		}
+	}
+
+	standings := s.stats.Standings(f, s.ratings, time.Now())
+	stats.Sort(standings, order)
+
+	res := &pb.LeaderboardResponse{
+		Total: int32(len(standings)),
+	}
+	for i := offset; i < len(standings) && i < offset+size; i++ {
+		st := standings[i]
+		name := st.Player
+		if a, err := s.accounts.Get(st.Player); err == nil {
+			name = a.Name
+		}
+		res.Entries = append(res.Entries, &pb.LeaderboardEntry{
+			Rank:     int32(i + 1),
+			PlayerId: st.Player,
+			Name:     name,
+			Rating:   st.Rating.Value,
+			Games:    int32(st.Games),
+			Wins:     int32(st.Wins),
+			Losses:   int32(st.Losses),
+			Draws:    int32(st.Draws),
+			WinRate:  st.WinRate(),
+		})
+	}
+	if offset+size < len(standings) {
+		res.NextPageToken = strconv.Itoa(offset + size)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/rating"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+	"github.com/movaua/rock-paper-scissors/pkg/stats"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLeaderboardPages(t *testing.T) {
+	s := newTestGameServer(t)
+
+	// p0 beats everyone, p1 beats everyone but p0 and so on.
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			winner, loser := fmt.Sprintf("p%d", i), fmt.Sprintf("p%d", j)
+			g := &stats.Game{
+				Ruleset:  "classic",
+				Finished: time.Now(),
+				Results: []stats.Result{
+					{Player: winner, Score: 1, Status: pb.EnumStatus_Winner},
+					{Player: loser, Status: pb.EnumStatus_Looser},
+				},
+			}
+			before := map[string]rating.Rating{winner: s.rating(winner), loser: s.rating(loser)}
+			after := rating.Rate(s.ratings, before, g.Scores())
+			g.Results[0].After, g.Results[1].After = after[winner], after[loser]
+			if err := s.stats.Record(g); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	var ranking []string
+	req := &pb.LeaderboardRequest{PageSize: 2}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("too many pages")
+		}
+		res, err := s.Leaderboard(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetTotal() != 5 {
+			t.Errorf("total = %d, want 5", res.GetTotal())
+		}
+		for _, e := range res.GetEntries() {
+			if int(e.GetRank()) != len(ranking)+1 {
+				t.Errorf("rank of %s = %d, want %d", e.GetPlayerId(), e.GetRank(), len(ranking)+1)
+			}
+			if want := s.rating(e.GetPlayerId()).Value; e.GetRating() != want {
+				t.Errorf("rating of %s = %v, want %v", e.GetPlayerId(), e.GetRating(), want)
+			}
+			ranking = append(ranking, e.GetPlayerId())
+		}
+		if res.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+
+	if fmt.Sprint(ranking) != "[p0 p1 p2 p3 p4]" {
+		t.Errorf("ranking = %v, want [p0 p1 p2 p3 p4]", ranking)
+	}
+
+	for _, token := range []string{"x", "-1"} {
+		_, err := s.Leaderboard(context.Background(), &pb.LeaderboardRequest{PageToken: token})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Leaderboard() with the page token %q error = %v, want InvalidArgument", token, err)
+		}
+	}
+}
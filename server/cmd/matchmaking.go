@@ -0,0 +1,237 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/matchmaking"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// waiter is a player waiting for a match.
+type waiter struct {
+	ticket  *matchmaking.Ticket
+	changed chan struct{} // signals that the queue has changed
+	found   chan *room    // receives the room of the found match, nil if the room cannot be opened
+}
+
+// FindMatch puts the player into the matchmaking queue and reports the queue position
+// until the player is matched with other players in a new game room.
+// The player who is not matched within the match timeout is removed from the queue.
+// The room of the match is abandoned if its players do not get ready within the match timeout.
+func (s *gameServer) FindMatch(req *pb.FindMatchRequest, stream pb.Gamer_FindMatchServer) error {
+	w, err := s.enqueue(req)
+	if err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(s.matchTimeout)
+	defer timer.Stop()
+
+	var last int32
+	for {
+		select {
+		case r := <-w.found:
+			if r == nil {
+				return status.Error(codes.Internal, "cannot open a game room for the match")
+			}
+			return stream.Send(&pb.MatchUpdate{
+				Room: r.info(),
+			})
+		case <-w.changed:
+			pos := s.queuePosition(w)
+			if pos == 0 || pos == last {
+				continue
+			}
+			last = pos
+			if err := stream.Send(&pb.MatchUpdate{Position: pos}); err != nil {
+				s.dequeue(w)
+				return err
+			}
+		case <-timer.C:
+			if s.dequeue(w) {
+				return status.Errorf(codes.DeadlineExceeded, "no match is found in %v", s.matchTimeout)
+			}
+		case <-stream.Context().Done():
+			s.dequeue(w)
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+// enqueue puts the player into the matchmaking queue and matches the waiting players.
+func (s *gameServer) enqueue(req *pb.FindMatchRequest) (*waiter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	playerID := req.GetPlayerId()
+	if s.findPlayer(playerID) == nil {
+		return nil, status.Errorf(codes.NotFound, "player %q is not found", playerID)
+	}
+	if cur, ok := s.playerRooms[playerID]; ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "player %q has already joined room %q", playerID, cur.id)
+	}
+	if _, ok := s.waiters[playerID]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "player %q is already waiting for a match", playerID)
+	}
+
+	size := int(req.GetMatchSize())
+	if size == 0 {
+		size = 2
+	}
+	if size < 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "match size must be at least 2, got %d", size)
+	}
+
+	ruleset := req.GetRuleset()
+	if ruleset == "" {
+		ruleset = s.defaults.GetRuleset()
+	}
+	if _, err := s.rulesets.Lookup(ruleset); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	w := &waiter{
+		ticket: &matchmaking.Ticket{
+			PlayerID: playerID,
+			Size:     size,
+			Ruleset:  ruleset,
+			Rating:   s.findPlayer(playerID).GetRating(),
+			Since:    time.Now(),
+		},
+		changed: make(chan struct{}, 1),
+		found:   make(chan *room, 1),
+	}
+	s.queue.Add(w.ticket)
+	s.waiters[playerID] = w
+
+	s.matchQueue()
+
+	return w, nil
+}
+
+// dequeue removes the player from the matchmaking queue.
+// It reports false if the player has already been matched.
+func (s *gameServer) dequeue(w *waiter) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.queue.Remove(w.ticket) {
+		return false
+	}
+	delete(s.waiters, w.ticket.PlayerID)
+	s.notifyWaiters()
+
+	return true
+}
+
+// queuePosition returns the player's position in the matchmaking queue.
+func (s *gameServer) queuePosition(w *waiter) int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return int32(s.queue.Position(w.ticket))
+}
+
+// matchInterval is the interval of matching the players
+// whose rating windows have grown while waiting.
+const matchInterval = time.Second
+
+// matchLoop matches the waiting players periodically until the context is done.
+func (s *gameServer) matchLoop(ctx context.Context) {
+	ticker := time.NewTicker(matchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		s.mu.Lock()
+		if s.queue.Len() > 0 {
+			s.matchQueue()
+		}
+		s.mu.Unlock()
+	}
+}
+
+// matchQueue opens a game room for every group of matched players. mu must be held.
+func (s *gameServer) matchQueue() {
+	for _, group := range s.queue.Match(time.Now()) {
+		t := group[0]
+		r, err := s.openRoom("match", &pb.RoomSettings{
+			Ruleset:    t.Ruleset,
+			MinPlayers: int32(t.Size),
+			MaxPlayers: int32(t.Size),
+		})
+
+		for _, gt := range group {
+			w := s.waiters[gt.PlayerID]
+			delete(s.waiters, gt.PlayerID)
+
+			if err != nil {
+				w.found <- nil
+				continue
+			}
+			if err := r.enter(s.findPlayer(gt.PlayerID)); err != nil {
+				w.found <- nil
+				continue
+			}
+			s.playerRooms[gt.PlayerID] = r
+			w.found <- r
+		}
+
+		if err == nil {
+			go s.expireMatch(s.ctx, r)
+		}
+	}
+
+	s.notifyWaiters()
+}
+
+// expireMatch abandons the room of a match whose players do not get ready within the match timeout,
+// so that the players who are ready do not wait for the others forever.
+func (s *gameServer) expireMatch(ctx context.Context, r *room) {
+	timer := time.NewTimer(s.matchTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-r.quorum:
+	case <-r.abandoned:
+	case <-ctx.Done():
+	case <-timer.C:
+		r.cancel()
+	}
+}
+
+// notifyWaiters signals the waiting players that the queue has changed. mu must be held.
+func (s *gameServer) notifyWaiters() {
+	for _, w := range s.waiters {
+		select {
+		case w.changed <- struct{}{}:
+		default:
+		}
+	}
+}
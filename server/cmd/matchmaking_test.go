@@ -0,0 +1,93 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMatchReadyTimeout(t *testing.T) {
+	s := newTestGameServer(t, func(cfg *serverConfig) {
+		cfg.matchTimeout = 100 * time.Millisecond
+	})
+	s.mu.Lock()
+	s.players[alice.GetId()] = alice
+	s.players[bob.GetId()] = bob
+	s.mu.Unlock()
+
+	var found chan *room
+	for _, p := range []*pb.Player{alice, bob} {
+		w, err := s.enqueue(&pb.FindMatchRequest{PlayerId: p.GetId()})
+		if err != nil {
+			t.Fatalf("enqueue(%s) = %v", p.GetName(), err)
+		}
+		found = w.found
+	}
+	r := <-found
+	if r == nil {
+		t.Fatal("no room is opened for the match")
+	}
+
+	// alice gets ready, bob never shows up
+	_, err := s.Ready(context.Background(), &pb.ReadyRequest{PlayerId: alice.GetId(), RoomId: r.id})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("Ready() = %v, want %v", err, codes.Aborted)
+	}
+	waitClosed(t, r.over, "room of the match is not closed")
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.mu.Lock()
+		_, joined := s.playerRooms[alice.GetId()]
+		s.mu.Unlock()
+		if !joined {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("alice is still in the room of the match")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	w, err := s.enqueue(&pb.FindMatchRequest{PlayerId: alice.GetId()})
+	if err != nil {
+		t.Fatalf("enqueue() again = %v", err)
+	}
+	s.dequeue(w)
+}
+
+func TestMatchLoopStops(t *testing.T) {
+	s := newTestGameServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		s.matchLoop(ctx)
+		close(stopped)
+	}()
+
+	cancel()
+	waitClosed(t, stopped, "match loop does not stop")
+}
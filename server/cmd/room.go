@@ -0,0 +1,709 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/game"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gameConfig is the configuration of a game.
+type gameConfig struct {
+	answerTimeout    time.Duration
+	reconnectTimeout time.Duration // time for the dropped players to come back to a started game
+	minPlayers       int
+	maxPlayers       int
+	ruleset          game.Ruleset
+	policy           game.Policy
+	ending           game.Ending
+	fairPlay         bool // the players commit to their choises before revealing them
+}
+
+// room is a game played by the players who joined the room.
+type room struct {
+	gameConfig
+	id           string
+	name         string
+	settings     *pb.RoomSettings
+	quorum       chan struct{} // closed when min players are ready
+	connected    chan struct{} // closed when min players have connected their Play streams
+	over         chan struct{} // closed when the game is over
+	abandoned    chan struct{} // closed when the last player leaves the room
+	mu           sync.Mutex    // protects all the fields below
+	players      []*pb.Player  // joined players in the order of joining
+	departed     []*pb.Player  // players who left the started game, they have forfeited it
+	isAbandoned  bool          // abandoned is closed
+	isConnected  bool          // connected is closed
+	ready        map[string]bool
+	started      bool
+	conns        map[string]*playerConn // connected Play streams by player ID
+	participants map[string]bool        // players of the current round, nil between rounds
+	choises      map[string]pb.EnumChoise
+	commitments  map[string][]byte // commitment hashes by player ID in fair play
+	nonces       map[string][]byte // nonces of the revealed choises by player ID in fair play
+	revealing    bool              // the commitments of the round are announced
+	match        *game.Match
+	final        *pb.Score     // the last score when the game is over, nil while it is played
+	changed      chan struct{} // signals the game loop that the state has changed
+}
+
+// playerConn is a Play stream of a connected player.
+type playerConn struct {
+	scores chan *pb.Score
+	done   chan struct{} // closed when the stream is closed
+	kicked chan struct{} // closed when the player leaves the room
+}
+
+func newRoom(id, name string, cfg gameConfig, settings *pb.RoomSettings) *room {
+	return &room{
+		gameConfig:  cfg,
+		id:          id,
+		name:        name,
+		settings:    settings,
+		quorum:      make(chan struct{}),
+		connected:   make(chan struct{}),
+		over:        make(chan struct{}),
+		abandoned:   make(chan struct{}),
+		ready:       make(map[string]bool),
+		conns:       make(map[string]*playerConn),
+		choises:     make(map[string]pb.EnumChoise),
+		commitments: make(map[string][]byte),
+		nonces:      make(map[string][]byte),
+		match:       game.NewMatch(cfg.ruleset, cfg.policy, cfg.ending),
+		changed:     make(chan struct{}, 1),
+	}
+}
+
+// info describes the room.
+func (r *room) info() *pb.Room {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	state := pb.EnumRoomState_Waiting
+	switch {
+	case r.final != nil:
+		state = pb.EnumRoomState_Over
+	case r.started:
+		state = pb.EnumRoomState_Playing
+	}
+
+	return &pb.Room{
+		Id:       r.id,
+		Name:     r.name,
+		Settings: r.settings,
+		Players:  append([]*pb.Player(nil), r.players...),
+		State:    state,
+	}
+}
+
+// enter adds the player to the room.
+func (r *room) enter(player *pb.Player) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.findPlayer(player.GetId()) != nil {
+		return nil
+	}
+	if r.match.Forfeited(player.GetId()) {
+		return status.Errorf(codes.FailedPrecondition, "player %q has left the game in room %q", player.GetId(), r.id)
+	}
+	if r.isAbandoned {
+		return status.Errorf(codes.FailedPrecondition, "room %q is abandoned", r.id)
+	}
+	if r.final != nil {
+		return status.Errorf(codes.FailedPrecondition, "the game in room %q is over", r.id)
+	}
+	if r.maxPlayers > 0 && len(r.players) >= r.maxPlayers {
+		return status.Errorf(codes.ResourceExhausted, "room %q is full: %d players joined", r.id, len(r.players))
+	}
+
+	r.players = append(r.players, player)
+
+	return nil
+}
+
+// exit removes the player from the room and closes the player's Play stream.
+// The room is abandoned when the last player leaves it.
+func (r *room) exit(playerID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.remove(playerID)
+}
+
+// withdraw removes the player from the room unless the game has started.
+// It reports whether the player was removed.
+func (r *room) withdraw(playerID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.started {
+		return false
+	}
+	r.remove(playerID)
+	return true
+}
+
+// remove removes the player from the room and abandons the room
+// when the last player leaves it. The player who leaves the started game forfeits it.
+// mu must be held.
+func (r *room) remove(playerID string) {
+	var found *pb.Player
+	for i, p := range r.players {
+		if p.GetId() == playerID {
+			r.players = append(r.players[:i], r.players[i+1:]...)
+			found = p
+			break
+		}
+	}
+	if found == nil {
+		return
+	}
+
+	if r.started && r.final == nil {
+		r.match.Forfeit(playerID)
+		r.departed = append(r.departed, found)
+	}
+
+	delete(r.ready, playerID)
+	if conn, ok := r.conns[playerID]; ok {
+		close(conn.kicked)
+		delete(r.conns, playerID)
+		r.notify()
+	}
+
+	if len(r.players) == 0 {
+		r.abandon()
+	}
+}
+
+// abandon closes the abandoned channel unless it is already closed. mu must be held.
+func (r *room) abandon() {
+	if r.isAbandoned {
+		return
+	}
+	r.isAbandoned = true
+	close(r.abandoned)
+}
+
+// cancel abandons the room if its game has not started yet
+// and returns the IDs of the players who were ready.
+// It reports false if the game has started.
+func (r *room) cancel() ([]string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.started {
+		return nil, false
+	}
+
+	var ready []string
+	for _, p := range r.players {
+		if r.ready[p.GetId()] {
+			ready = append(ready, p.GetId())
+		}
+	}
+
+	for id, conn := range r.conns {
+		close(conn.kicked)
+		delete(r.conns, id)
+	}
+	r.ready = make(map[string]bool)
+	if len(r.players) > 0 {
+		r.players = nil
+		r.abandon()
+	}
+
+	return ready, true
+}
+
+// abort abandons the room if min players have not connected to its game yet
+// and returns the IDs of the players who have connected.
+// It reports false if the game has got min players connected.
+func (r *room) abort() ([]string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.isConnected {
+		return nil, false
+	}
+
+	var connected []string
+	for _, p := range r.players {
+		if _, ok := r.conns[p.GetId()]; ok {
+			connected = append(connected, p.GetId())
+		}
+	}
+
+	for id, conn := range r.conns {
+		close(conn.kicked)
+		delete(r.conns, id)
+	}
+	r.ready = make(map[string]bool)
+	r.players = nil
+	r.abandon()
+
+	return connected, true
+}
+
+// markReady marks the player as ready and opens the game when min players are ready.
+func (r *room) markReady(playerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.findPlayer(playerID) == nil {
+		return status.Errorf(codes.FailedPrecondition, "player %q has not joined room %q", playerID, r.id)
+	}
+	if r.final != nil {
+		return status.Errorf(codes.FailedPrecondition, "the game in room %q is over", r.id)
+	}
+	if r.ready[playerID] {
+		return nil
+	}
+
+	r.ready[playerID] = true
+	if !r.started && len(r.ready) == r.minPlayers {
+		r.started = true
+		close(r.quorum)
+	}
+
+	return nil
+}
+
+// join registers the Play stream of the player.
+func (r *room) join(playerID string) (*playerConn, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.ready[playerID] {
+		return nil, status.Errorf(codes.FailedPrecondition, "player %q is not ready in room %q", playerID, r.id)
+	}
+	if r.final != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "the game in room %q is over", r.id)
+	}
+	if _, ok := r.conns[playerID]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "player %q is already playing", playerID)
+	}
+
+	conn := &playerConn{
+		scores: make(chan *pb.Score),
+		done:   make(chan struct{}),
+		kicked: make(chan struct{}),
+	}
+	r.conns[playerID] = conn
+	if !r.isConnected && len(r.conns) >= r.minPlayers {
+		r.isConnected = true
+		close(r.connected)
+	}
+	r.notify()
+
+	return conn, nil
+}
+
+// leave unregisters the Play stream of the player.
+func (r *room) leave(playerID string, conn *playerConn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	close(conn.done)
+	if r.conns[playerID] == conn {
+		delete(r.conns, playerID)
+	}
+	r.notify()
+}
+
+// play takes the message of the player's Play stream into account.
+func (r *room) play(playerID string, c *pb.Choise) {
+	switch {
+	case c.GetCommitment() != nil:
+		r.commit(playerID, c.GetCommitment().GetHash())
+	case c.GetReveal() != nil:
+		r.reveal(playerID, c.GetReveal().GetChoise(), c.GetReveal().GetNonce())
+	default:
+		r.choose(playerID, c.GetChoise())
+	}
+}
+
+// choose records the player's choise for the current round,
+// or for the next one if it is made between rounds.
+// Only the first choise of a player in a round is taken into account,
+// choises which are not weapons of the game ruleset are ignored,
+// as well as all the plain choises in fair play.
+func (r *room) choose(playerID string, choise pb.EnumChoise) {
+	if r.fairPlay || !game.IsWeapon(r.ruleset, choise) {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.conns[playerID]; !ok {
+		return
+	}
+	if _, ok := r.choises[playerID]; ok {
+		return
+	}
+	r.choises[playerID] = choise
+	r.notify()
+}
+
+// commit records the player's commitment for the current round in fair play,
+// or for the next one if it is made between rounds.
+// Only the first commitment of a player in a round is taken into account,
+// commitments made while the choises of the round are revealed are ignored.
+func (r *room) commit(playerID string, hash []byte) {
+	if !r.fairPlay || len(hash) != sha256.Size {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.conns[playerID]; !ok || r.revealing {
+		return
+	}
+	if _, ok := r.commitments[playerID]; ok {
+		return
+	}
+	r.commitments[playerID] = hash
+	r.notify()
+}
+
+// reveal records the choise the player has committed to in the current round.
+// The player forfeits the round if the choise does not match the commitment
+// or is not a weapon of the game ruleset.
+func (r *room) reveal(playerID string, choise pb.EnumChoise, nonce []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.revealing || !r.participants[playerID] {
+		return
+	}
+	hash, ok := r.commitments[playerID]
+	if !ok {
+		return
+	}
+	if _, ok := r.choises[playerID]; ok {
+		return
+	}
+
+	if !game.IsWeapon(r.ruleset, choise) || !game.CheckReveal(hash, choise, nonce) {
+		choise = pb.EnumChoise_UnknownChoise
+		nonce = nil
+	}
+	r.choises[playerID] = choise
+	r.nonces[playerID] = nonce
+	r.notify()
+}
+
+// notify wakes up the game loop. mu must be held.
+func (r *room) notify() {
+	select {
+	case r.changed <- struct{}{}:
+	default:
+	}
+}
+
+// findPlayer returns the joined player by its ID or nil. mu must be held.
+func (r *room) findPlayer(playerID string) *pb.Player {
+	for _, p := range r.players {
+		if p.GetId() == playerID {
+			return p
+		}
+	}
+	return nil
+}
+
+// run is the game loop: it plays rounds one by one while there are enough connected players
+// until the game is over, forfeited or the room is abandoned.
+func (r *room) run() {
+	defer close(r.over)
+
+	for {
+		if ok := r.waitPlayers(); !ok {
+			return
+		}
+		if over := r.playRound(); over {
+			return
+		}
+	}
+}
+
+// waitPlayers blocks until min players are ready and connected to start a round.
+// Once min players have connected, the players who drop out have the reconnect timeout
+// to come back, otherwise the absent players forfeit the game.
+// It reports false if the room is abandoned or the game is forfeited.
+func (r *room) waitPlayers() bool {
+	select {
+	case <-r.quorum:
+	case <-r.abandoned:
+		return false
+	}
+
+	var expired <-chan time.Time
+	for {
+		r.mu.Lock()
+		n, connected := len(r.conns), r.isConnected
+		r.mu.Unlock()
+
+		if n >= r.minPlayers {
+			return true
+		}
+		if connected && expired == nil {
+			timer := time.NewTimer(r.reconnectTimeout)
+			defer timer.Stop()
+			expired = timer.C
+		}
+
+		select {
+		case <-r.changed:
+		case <-r.abandoned:
+			return false
+		case <-expired:
+			r.broadcast(r.forfeit())
+			return false
+		}
+	}
+}
+
+// playRound collects the choises of all connected players until every one of them has chosen
+// or the answer timeout expires, then resolves the round and broadcasts the score.
+// In fair play the players commit to their choises first and the commitments are broadcast,
+// then the committed players reveal their choises.
+// It reports whether the game is over.
+func (r *room) playRound() bool {
+	r.mu.Lock()
+	r.participants = make(map[string]bool, len(r.conns))
+	for id := range r.conns {
+		r.participants[id] = true
+	}
+	r.mu.Unlock()
+
+	if r.fairPlay {
+		r.waitAnswers(r.allCommitted)
+		r.broadcast(r.openReveal())
+	}
+
+	r.waitAnswers(r.allChose)
+
+	score, conns, over := r.finishRound()
+	r.broadcast(score, conns)
+
+	return over
+}
+
+// waitAnswers blocks until done reports true or the answer timeout expires.
+func (r *room) waitAnswers(done func() bool) {
+	timer := time.NewTimer(r.answerTimeout)
+	defer timer.Stop()
+
+	for !done() {
+		select {
+		case <-r.changed:
+		case <-timer.C:
+			return
+		}
+	}
+}
+
+// broadcast sends the score to the connected players.
+func (r *room) broadcast(score *pb.Score, conns []*playerConn) {
+	for _, conn := range conns {
+		select {
+		case conn.scores <- score:
+		case <-conn.done:
+		case <-conn.kicked:
+		}
+	}
+}
+
+// allCommitted reports whether every connected participant of the round has made a commitment.
+func (r *room) allCommitted() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id := range r.participants {
+		if _, ok := r.conns[id]; !ok {
+			continue
+		}
+		if _, ok := r.commitments[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// allChose reports whether every connected participant of the round has made a choise,
+// in fair play only the committed participants have to reveal one.
+func (r *room) allChose() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id := range r.participants {
+		if _, ok := r.conns[id]; !ok {
+			continue
+		}
+		if _, ok := r.commitments[id]; r.fairPlay && !ok {
+			continue
+		}
+		if _, ok := r.choises[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// openReveal starts revealing the choises of the round
+// and returns the score with the commitments of the participants
+// along with the connected players to send it to.
+func (r *room) openReveal() (*pb.Score, []*playerConn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.revealing = true
+
+	score := &pb.Score{}
+	for _, p := range r.players {
+		hash, ok := r.commitments[p.GetId()]
+		if !ok || !r.participants[p.GetId()] {
+			continue
+		}
+		score.Commitments = append(score.Commitments, &pb.Commitment{
+			Hash:   hash,
+			Player: p,
+		})
+	}
+
+	return score, r.connections()
+}
+
+// finishRound resolves the current round, updates the game score
+// and returns the score to broadcast along with the connected players to send it to.
+// It reports whether the game is over.
+func (r *room) finishRound() (*pb.Score, []*playerConn, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	choises := make(map[string]pb.EnumChoise, len(r.participants))
+	nonces := make(map[string][]byte, len(r.nonces))
+	for id := range r.participants {
+		choises[id] = r.choises[id]
+		delete(r.choises, id)
+		if nonce, ok := r.nonces[id]; ok {
+			nonces[id] = nonce
+		}
+		delete(r.nonces, id)
+		delete(r.commitments, id)
+	}
+	r.revealing = false
+
+	statuses := r.match.Play(choises)
+
+	score := &pb.Score{}
+	for _, p := range r.players {
+		st, ok := statuses[p.GetId()]
+		if !ok {
+			continue
+		}
+		score.RoundResults = append(score.RoundResults, &pb.RoundResult{
+			Player: p,
+			Choise: choises[p.GetId()],
+			Status: st,
+			Nonce:  nonces[p.GetId()],
+		})
+	}
+
+	score.GameResults = r.gameResults()
+
+	r.participants = nil
+
+	over := r.match.Over()
+	if over {
+		r.final = score
+	}
+
+	return score, r.connections(), over
+}
+
+// forfeit ends the game as a forfeit of the players who are not connected
+// and returns the final score along with the connected players to send it to.
+func (r *room) forfeit() (*pb.Score, []*playerConn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range r.players {
+		if _, ok := r.conns[p.GetId()]; ok {
+			r.match.Join(p.GetId())
+		} else {
+			r.match.Forfeit(p.GetId())
+		}
+	}
+
+	r.final = &pb.Score{GameResults: r.gameResults()}
+
+	return r.final, r.connections()
+}
+
+// gameResults returns the current game results of the players,
+// including the ones who have left the game. mu must be held.
+func (r *room) gameResults() []*pb.GameResult {
+	players := make([]*pb.Player, 0, len(r.players)+len(r.departed))
+	players = append(players, r.players...)
+	players = append(players, r.departed...)
+
+	var results []*pb.GameResult
+	statuses := r.match.Statuses()
+	for _, p := range players {
+		st, ok := statuses[p.GetId()]
+		if !ok {
+			continue
+		}
+		results = append(results, &pb.GameResult{
+			Player:    p,
+			Score:     r.match.Score(p.GetId()),
+			Status:    st,
+			Rounds:    int32(r.match.Rounds()),
+			Forfeited: r.match.Forfeited(p.GetId()),
+		})
+	}
+	return results
+}
+
+// connections returns the connected Play streams. mu must be held.
+func (r *room) connections() []*playerConn {
+	conns := make([]*playerConn, 0, len(r.conns))
+	for _, conn := range r.conns {
+		conns = append(conns, conn)
+	}
+	return conns
+}
+
+// finalScore returns the last score of the game if it is over or nil.
+func (r *room) finalScore() *pb.Score {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.final
+}
@@ -0,0 +1,357 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/game"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	alice = &pb.Player{Id: "a", Name: "alice"}
+	bob   = &pb.Player{Id: "b", Name: "bob"}
+	carol = &pb.Player{Id: "c", Name: "carol"}
+)
+
+// newTestRoom starts the game loop of a room for two players of the classic ruleset.
+func newTestRoom(t *testing.T, ending game.Ending, reconnectTimeout time.Duration) *room {
+	t.Helper()
+
+	r := newRoom("1", "test", gameConfig{
+		answerTimeout:    time.Second,
+		reconnectTimeout: reconnectTimeout,
+		minPlayers:       2,
+		ruleset:          game.Classic,
+		policy:           game.Draw,
+		ending:           ending,
+	}, &pb.RoomSettings{})
+	go r.run()
+	return r
+}
+
+// seat enters the players into the room, gets them ready and connects their Play streams.
+func seat(t *testing.T, r *room, players ...*pb.Player) map[string]*playerConn {
+	t.Helper()
+
+	conns := make(map[string]*playerConn, len(players))
+	for _, p := range players {
+		if err := r.enter(p); err != nil {
+			t.Fatalf("enter(%s) = %v", p.GetName(), err)
+		}
+		if err := r.markReady(p.GetId()); err != nil {
+			t.Fatalf("markReady(%s) = %v", p.GetName(), err)
+		}
+	}
+	for _, p := range players {
+		conn, err := r.join(p.GetId())
+		if err != nil {
+			t.Fatalf("join(%s) = %v", p.GetName(), err)
+		}
+		conns[p.GetId()] = conn
+	}
+	return conns
+}
+
+// playRound makes the choises and returns the score every connected player receives.
+func playRound(t *testing.T, r *room, conns map[string]*playerConn, choises map[string]pb.EnumChoise) *pb.Score {
+	t.Helper()
+
+	for id, c := range choises {
+		r.play(id, &pb.Choise{Choise: c})
+	}
+	return recvAll(t, conns)
+}
+
+// recvAll returns the next score every connected player receives.
+func recvAll(t *testing.T, conns map[string]*playerConn) *pb.Score {
+	t.Helper()
+
+	scores := make(chan *pb.Score, len(conns))
+	for _, conn := range conns {
+		conn := conn
+		go func() {
+			select {
+			case score := <-conn.scores:
+				scores <- score
+			case <-time.After(5 * time.Second):
+				scores <- nil
+			}
+		}()
+	}
+
+	var score *pb.Score
+	for range conns {
+		if score = <-scores; score == nil {
+			t.Fatal("no score in 5s")
+		}
+	}
+	return score
+}
+
+// recvScore returns the next score sent to the Play stream.
+func recvScore(t *testing.T, conn *playerConn) *pb.Score {
+	t.Helper()
+
+	select {
+	case score := <-conn.scores:
+		return score
+	case <-time.After(5 * time.Second):
+		t.Fatal("no score in 5s")
+	}
+	return nil
+}
+
+// recvFinal returns the final score sent to the Play stream skipping the scores of the rounds.
+func recvFinal(t *testing.T, r *room, conn *playerConn) *pb.Score {
+	t.Helper()
+
+	for {
+		score := recvScore(t, conn)
+		if score == r.finalScore() {
+			return score
+		}
+	}
+}
+
+// waitClosed waits for the channel to be closed.
+func waitClosed(t *testing.T, ch <-chan struct{}, what string) {
+	t.Helper()
+
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s in 5s", what)
+	}
+}
+
+// gameResult returns the game result of the player in the score or nil.
+func gameResult(score *pb.Score, playerID string) *pb.GameResult {
+	for _, gr := range score.GetGameResults() {
+		if gr.GetPlayer().GetId() == playerID {
+			return gr
+		}
+	}
+	return nil
+}
+
+func TestRoomGame(t *testing.T) {
+	r := newTestRoom(t, game.FirstTo(1), time.Second)
+	conns := seat(t, r, alice, bob)
+
+	score := playRound(t, r, conns, map[string]pb.EnumChoise{"a": pb.EnumChoise_Stone, "b": pb.EnumChoise_Scissors})
+	if got := gameResult(score, "a").GetStatus(); got != pb.EnumStatus_Winner {
+		t.Errorf("game status of alice = %v, want Winner", got)
+	}
+	if got := gameResult(score, "b").GetStatus(); got != pb.EnumStatus_Looser {
+		t.Errorf("game status of bob = %v, want Looser", got)
+	}
+
+	waitClosed(t, r.over, "game is not over")
+	if r.finalScore() != score {
+		t.Errorf("final score = %v, want the last score", r.finalScore())
+	}
+	if got := r.info().GetState(); got != pb.EnumRoomState_Over {
+		t.Errorf("state = %v, want Over", got)
+	}
+	if err := r.enter(carol); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("enter() after the game = %v, want %v", err, codes.FailedPrecondition)
+	}
+	if _, err := r.join("a"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("join() after the game = %v, want %v", err, codes.FailedPrecondition)
+	}
+}
+
+func TestRoomMaxPlayers(t *testing.T) {
+	r := newRoom("1", "test", gameConfig{minPlayers: 2, maxPlayers: 2, ruleset: game.Classic, policy: game.Draw, ending: game.Unlimited}, &pb.RoomSettings{})
+	for _, p := range []*pb.Player{alice, bob, alice} {
+		if err := r.enter(p); err != nil {
+			t.Fatalf("enter(%s) = %v", p.GetName(), err)
+		}
+	}
+	if err := r.enter(carol); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("enter() of a full room = %v, want %v", err, codes.ResourceExhausted)
+	}
+	if err := r.markReady("c"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("markReady() of a stranger = %v, want %v", err, codes.FailedPrecondition)
+	}
+	if _, err := r.join("a"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("join() before ready = %v, want %v", err, codes.FailedPrecondition)
+	}
+}
+
+func TestRoomAbandoned(t *testing.T) {
+	r := newTestRoom(t, game.Unlimited, time.Second)
+	for _, p := range []*pb.Player{alice, bob} {
+		if err := r.enter(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !r.withdraw("a") {
+		t.Fatal("withdraw() before the game = false")
+	}
+	r.exit("b")
+
+	waitClosed(t, r.abandoned, "room is not abandoned")
+	waitClosed(t, r.over, "game loop has not stopped")
+	if r.finalScore() != nil {
+		t.Errorf("final score = %v, want nil", r.finalScore())
+	}
+	if err := r.enter(carol); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("enter() of an abandoned room = %v, want %v", err, codes.FailedPrecondition)
+	}
+}
+
+func TestRoomForfeit(t *testing.T) {
+	r := newTestRoom(t, game.Unlimited, 50*time.Millisecond)
+	conns := seat(t, r, alice, bob)
+
+	playRound(t, r, conns, map[string]pb.EnumChoise{"a": pb.EnumChoise_Stone, "b": pb.EnumChoise_Paper})
+
+	// bob leads but his Play stream drops for good
+	r.leave("b", conns["b"])
+	final := recvFinal(t, r, conns["a"])
+	waitClosed(t, r.over, "game is not over")
+
+	a, b := gameResult(final, "a"), gameResult(final, "b")
+	if a.GetStatus() != pb.EnumStatus_Winner || a.GetForfeited() {
+		t.Errorf("result of alice = %v, want a Winner who has not forfeited", a)
+	}
+	if b.GetStatus() != pb.EnumStatus_Looser || !b.GetForfeited() || b.GetScore() != 1 {
+		t.Errorf("result of bob = %v, want a Looser with the score 1 who has forfeited", b)
+	}
+	if r.finalScore() != final {
+		t.Errorf("final score = %v, want the forfeit", r.finalScore())
+	}
+}
+
+func TestRoomForfeitBeforeRound(t *testing.T) {
+	r := newTestRoom(t, game.Unlimited, 50*time.Millisecond)
+	conns := seat(t, r, alice, bob)
+
+	// bob drops out before the first round
+	r.leave("b", conns["b"])
+	final := recvFinal(t, r, conns["a"])
+	waitClosed(t, r.over, "game is not over")
+
+	if a := gameResult(final, "a"); a.GetStatus() != pb.EnumStatus_Winner || a.GetForfeited() {
+		t.Errorf("result of alice = %v, want a Winner who has not forfeited", a)
+	}
+	if b := gameResult(final, "b"); b.GetStatus() != pb.EnumStatus_Looser || !b.GetForfeited() {
+		t.Errorf("result of bob = %v, want a Looser who has forfeited", b)
+	}
+}
+
+func TestRoomReconnect(t *testing.T) {
+	r := newTestRoom(t, game.FirstTo(1), time.Minute)
+	conns := seat(t, r, alice, bob)
+
+	r.leave("b", conns["b"])
+	conn, err := r.join("b")
+	if err != nil {
+		t.Fatalf("join() again = %v", err)
+	}
+	conns["b"] = conn
+
+	score := playRound(t, r, conns, map[string]pb.EnumChoise{"a": pb.EnumChoise_Stone, "b": pb.EnumChoise_Paper})
+	waitClosed(t, r.over, "game is not over")
+	if b := gameResult(score, "b"); b.GetStatus() != pb.EnumStatus_Winner || b.GetForfeited() {
+		t.Errorf("result of bob = %v, want a Winner who has not forfeited", b)
+	}
+}
+
+func TestRoomLeaveStarted(t *testing.T) {
+	r := newTestRoom(t, game.Unlimited, 50*time.Millisecond)
+	conns := seat(t, r, alice, bob)
+
+	playRound(t, r, conns, map[string]pb.EnumChoise{"a": pb.EnumChoise_Stone, "b": pb.EnumChoise_Scissors})
+
+	if r.withdraw("b") {
+		t.Fatal("withdraw() after the start = true")
+	}
+	r.exit("b")
+	waitClosed(t, conns["b"].kicked, "bob is not kicked")
+
+	final := recvFinal(t, r, conns["a"])
+	waitClosed(t, r.over, "game is not over")
+	if a := gameResult(final, "a"); a.GetStatus() != pb.EnumStatus_Winner {
+		t.Errorf("result of alice = %v, want Winner", a)
+	}
+	if b := gameResult(final, "b"); b.GetStatus() != pb.EnumStatus_Looser || !b.GetForfeited() {
+		t.Errorf("result of bob = %v, want a Looser who has forfeited", b)
+	}
+	if err := r.enter(bob); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("enter() of the player who left = %v, want %v", err, codes.FailedPrecondition)
+	}
+}
+
+func TestRoomFairPlay(t *testing.T) {
+	r := newRoom("1", "test", gameConfig{
+		answerTimeout: time.Second,
+		minPlayers:    2,
+		ruleset:       game.Classic,
+		policy:        game.Draw,
+		ending:        game.Unlimited,
+		fairPlay:      true,
+	}, &pb.RoomSettings{})
+	go r.run()
+	conns := seat(t, r, alice, bob)
+
+	nonce := func(b byte) []byte { return bytes.Repeat([]byte{b}, game.MinNonceSize) }
+	commit := func(id string, c pb.EnumChoise, n []byte) {
+		r.play(id, &pb.Choise{Commitment: &pb.Commitment{Hash: game.Commit(c, n)}})
+	}
+	reveal := func(id string, c pb.EnumChoise, n []byte) {
+		r.play(id, &pb.Choise{Reveal: &pb.Reveal{Choise: c, Nonce: n}})
+	}
+
+	commit("a", pb.EnumChoise_Stone, nonce(1))
+	commit("b", pb.EnumChoise_Paper, nonce(2))
+	if score := recvAll(t, conns); len(score.GetCommitments()) != 2 {
+		t.Fatalf("score = %v, want the commitments of both players", score)
+	}
+
+	// bob cannot change his commitment once the commitments are announced
+	commit("b", pb.EnumChoise_Scissors, nonce(3))
+	// alice reveals another choise than the committed one
+	reveal("a", pb.EnumChoise_Scissors, nonce(1))
+	reveal("b", pb.EnumChoise_Paper, nonce(2))
+
+	score := recvAll(t, conns)
+	for _, rr := range score.GetRoundResults() {
+		switch rr.GetPlayer().GetId() {
+		case "a":
+			if rr.GetChoise() != pb.EnumChoise_UnknownChoise || rr.GetStatus() != pb.EnumStatus_Looser {
+				t.Errorf("round result of alice = %v, want a forfeit of the round", rr)
+			}
+		case "b":
+			if rr.GetChoise() != pb.EnumChoise_Paper || rr.GetStatus() != pb.EnumStatus_Winner {
+				t.Errorf("round result of bob = %v, want a Winner with the committed Paper", rr)
+			}
+		}
+	}
+	if len(score.GetRoundResults()) != 2 {
+		t.Errorf("round results = %v, want 2", score.GetRoundResults())
+	}
+}
@@ -0,0 +1,319 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/game"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateRoom creates a new game room and joins the player to it.
+func (s *gameServer) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest) (*pb.Room, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player := s.findPlayer(req.GetPlayerId())
+	if player == nil {
+		return nil, status.Errorf(codes.NotFound, "player %q is not found", req.GetPlayerId())
+	}
+	if cur, ok := s.playerRooms[player.GetId()]; ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "player %q has already joined room %q", player.GetId(), cur.id)
+	}
+	if _, ok := s.waiters[player.GetId()]; ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "player %q is waiting for a match", player.GetId())
+	}
+
+	r, err := s.openRoom(req.GetName(), req.GetSettings())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := r.enter(player); err != nil {
+		return nil, err
+	}
+	s.playerRooms[player.GetId()] = r
+
+	return r.info(), nil
+}
+
+// ListRooms lists the game rooms.
+func (s *gameServer) ListRooms(ctx context.Context, req *pb.ListRoomsRequest) (*pb.ListRoomsResponse, error) {
+	s.mu.Lock()
+	rooms := make([]*room, 0, len(s.rooms))
+	for _, r := range s.rooms {
+		rooms = append(rooms, r)
+	}
+	s.mu.Unlock()
+
+	sort.Slice(rooms, func(i, j int) bool {
+		a, _ := strconv.Atoi(rooms[i].id)
+		b, _ := strconv.Atoi(rooms[j].id)
+		return a < b
+	})
+
+	resp := &pb.ListRoomsResponse{}
+	for _, r := range rooms {
+		resp.Rooms = append(resp.Rooms, r.info())
+	}
+	return resp, nil
+}
+
+// JoinRoom joins the player to the game room.
+func (s *gameServer) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*pb.Room, error) {
+	if req.GetRoomId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "room ID is empty")
+	}
+
+	r, err := s.enterRoom(req.GetPlayerId(), req.GetRoomId())
+	if err != nil {
+		return nil, err
+	}
+	return r.info(), nil
+}
+
+// LeaveRoom removes the player from the game room.
+func (s *gameServer) LeaveRoom(ctx context.Context, req *pb.LeaveRoomRequest) (*pb.LeaveRoomResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.rooms[req.GetRoomId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "room %q is not found", req.GetRoomId())
+	}
+	if s.playerRooms[req.GetPlayerId()] != r {
+		return nil, status.Errorf(codes.FailedPrecondition, "player %q has not joined room %q", req.GetPlayerId(), r.id)
+	}
+
+	delete(s.playerRooms, req.GetPlayerId())
+	r.exit(req.GetPlayerId())
+
+	return &pb.LeaveRoomResponse{}, nil
+}
+
+// withdraw removes the player who is no longer waiting for the game from the room
+// unless the game has already started.
+func (s *gameServer) withdraw(playerID string, r *room) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !r.withdraw(playerID) {
+		return
+	}
+	if s.playerRooms[playerID] == r {
+		delete(s.playerRooms, playerID)
+	}
+}
+
+// enterRoom joins the player to the room, the empty room ID means the default room.
+func (s *gameServer) enterRoom(playerID, roomID string) (*room, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player := s.findPlayer(playerID)
+	if player == nil {
+		return nil, status.Errorf(codes.NotFound, "player %q is not found", playerID)
+	}
+
+	r := s.defaultRoom
+	if roomID != "" {
+		var ok bool
+		if r, ok = s.rooms[roomID]; !ok {
+			return nil, status.Errorf(codes.NotFound, "room %q is not found", roomID)
+		}
+	}
+
+	if cur, ok := s.playerRooms[playerID]; ok && cur != r {
+		return nil, status.Errorf(codes.FailedPrecondition, "player %q has already joined room %q", playerID, cur.id)
+	}
+	if _, ok := s.waiters[playerID]; ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "player %q is waiting for a match", playerID)
+	}
+
+	if err := r.enter(player); err != nil {
+		return nil, err
+	}
+	s.playerRooms[playerID] = r
+
+	return r, nil
+}
+
+// findRoom returns the room by its ID, the empty ID means the default room.
+func (s *gameServer) findRoom(roomID string) (*room, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if roomID == "" {
+		return s.defaultRoom, nil
+	}
+	r, ok := s.rooms[roomID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "room %q is not found", roomID)
+	}
+	return r, nil
+}
+
+// openRoom creates a new room and starts its game loop. mu must be held.
+func (s *gameServer) openRoom(name string, settings *pb.RoomSettings) (*room, error) {
+	cfg, settings, err := s.roomConfig(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s.lastRoomID++
+	r := newRoom(strconv.Itoa(s.lastRoomID), name, cfg, settings)
+	s.rooms[r.id] = r
+
+	go func() {
+		r.run()
+		s.closeRoom(r)
+	}()
+
+	return r, nil
+}
+
+// closeRoom records the game of the room if it is over and removes the room.
+// A new default room replaces the closed one.
+func (s *gameServer) closeRoom(r *room) {
+	s.recordGame(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.rooms, r.id)
+	for id, pr := range s.playerRooms {
+		if pr == r {
+			delete(s.playerRooms, id)
+		}
+	}
+
+	if r == s.defaultRoom {
+		dr, err := s.openRoom(r.name, nil)
+		if err != nil {
+			fmt.Println("cannot open default room:", err)
+			return
+		}
+		s.defaultRoom = dr
+	}
+}
+
+// roomConfig returns the game config of a room with the settings
+// along with the effective settings where unset ones are taken from the server defaults.
+func (s *gameServer) roomConfig(settings *pb.RoomSettings) (gameConfig, *pb.RoomSettings, error) {
+	st := proto.Clone(s.defaults).(*pb.RoomSettings)
+	if settings.GetRuleset() != "" {
+		st.Ruleset = settings.GetRuleset()
+	}
+	if settings.GetPolicy() != "" {
+		st.Policy = settings.GetPolicy()
+	}
+	if settings.GetChoiseTimeoutSeconds() != 0 {
+		st.ChoiseTimeoutSeconds = settings.GetChoiseTimeoutSeconds()
+	}
+	if settings.GetMinPlayers() != 0 {
+		st.MinPlayers = settings.GetMinPlayers()
+	}
+	if settings.GetMaxPlayers() != 0 {
+		st.MaxPlayers = settings.GetMaxPlayers()
+	}
+	if settings.GetBestOf() != 0 || settings.GetFirstTo() != 0 || settings.GetRounds() != 0 {
+		st.BestOf = settings.GetBestOf()
+		st.FirstTo = settings.GetFirstTo()
+		st.Rounds = settings.GetRounds()
+		st.SuddenDeath = settings.GetSuddenDeath()
+	}
+	if settings.GetFairPlay() {
+		st.FairPlay = true
+	}
+
+	var cfg gameConfig
+
+	if st.GetChoiseTimeoutSeconds() <= 0 {
+		return cfg, nil, fmt.Errorf("choise timeout must be positive, got %d", st.GetChoiseTimeoutSeconds())
+	}
+	if st.GetMinPlayers() < 2 {
+		return cfg, nil, fmt.Errorf("min players must be at least 2, got %d", st.GetMinPlayers())
+	}
+	if st.GetMaxPlayers() != 0 && st.GetMaxPlayers() < st.GetMinPlayers() {
+		return cfg, nil, fmt.Errorf("max players %d is less than min players %d", st.GetMaxPlayers(), st.GetMinPlayers())
+	}
+
+	ruleset, err := s.rulesets.Lookup(st.GetRuleset())
+	if err != nil {
+		return cfg, nil, err
+	}
+
+	policy, err := game.LookupPolicy(st.GetPolicy())
+	if err != nil {
+		return cfg, nil, err
+	}
+
+	ending, err := newEnding(policy, int(st.GetBestOf()), int(st.GetFirstTo()), int(st.GetRounds()), st.GetSuddenDeath())
+	if err != nil {
+		return cfg, nil, err
+	}
+
+	cfg = gameConfig{
+		answerTimeout:    time.Duration(st.GetChoiseTimeoutSeconds()) * time.Second,
+		reconnectTimeout: s.reconnectTimeout,
+		minPlayers:       int(st.GetMinPlayers()),
+		maxPlayers:       int(st.GetMaxPlayers()),
+		ruleset:          ruleset,
+		policy:           policy,
+		ending:           ending,
+		fairPlay:         st.GetFairPlay(),
+	}
+	return cfg, st, nil
+}
+
+// newEnding returns the game ending by the game length settings, at most one of them can be set.
+func newEnding(policy game.Policy, bestOf, firstTo, rounds int, suddenDeath bool) (game.Ending, error) {
+	var ending game.Ending = game.Unlimited
+	set := 0
+	if bestOf > 0 {
+		ending = game.BestOf(bestOf, policy)
+		set++
+	}
+	if firstTo > 0 {
+		ending = game.FirstTo(firstTo)
+		set++
+	}
+	if rounds > 0 {
+		ending = game.FixedRounds(rounds)
+		set++
+	}
+	if set > 1 {
+		return nil, fmt.Errorf("only one of best of, first to and rounds can be set")
+	}
+	if suddenDeath {
+		if set == 0 {
+			return nil, fmt.Errorf("sudden death needs one of best of, first to and rounds")
+		}
+		ending = game.SuddenDeath(ending)
+	}
+	return ending, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/movaua/rock-paper-scissors/pkg/game"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+
+	"github.com/spf13/viper"
+)
+
+// rulesetConfig is a custom ruleset definition in the config file, e.g.
+//
+//	rulesets:
+//	  - name: bears
+//	    balanced: true
+//	    weapons:
+//	      - name: Bear
+//	        beats: [Ninja]
+//	      - name: Ninja
+//	        beats: [Cowboy]
+//	      - name: Cowboy
+//	        beats: [Bear]
+type rulesetConfig struct {
+	Name     string
+	Balanced bool // requires each weapon to beat exactly half of the other weapons
+	Weapons  []game.WeaponDef
+}
+
+// loadRulesets returns the built-in rulesets along with the custom rulesets from the config file.
+func loadRulesets() (game.Rulesets, error) {
+	var cfgs []rulesetConfig
+	if err := viper.UnmarshalKey("rulesets", &cfgs); err != nil {
+		return nil, fmt.Errorf("cannot read rulesets config: %w", err)
+	}
+
+	rulesets := game.Builtin()
+	for _, cfg := range cfgs {
+		r, err := game.NewGraph(cfg.Name, cfg.Weapons)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ruleset config: %w", err)
+		}
+		if cfg.Balanced {
+			if err := game.CheckBalanced(r); err != nil {
+				return nil, fmt.Errorf("invalid ruleset config: %w", err)
+			}
+		}
+		if err := rulesets.Add(r); err != nil {
+			return nil, fmt.Errorf("invalid ruleset config: %w", err)
+		}
+	}
+
+	return rulesets, nil
+}
+
+// Rulesets lists the rulesets available on the server.
+func (s *gameServer) Rulesets(ctx context.Context, r *pb.RulesetsRequest) (*pb.RulesetsResponse, error) {
+	resp := &pb.RulesetsResponse{}
+	for _, name := range s.rulesets.Names() {
+		resp.Rulesets = append(resp.Rulesets, &pb.Ruleset{
+			Name:    name,
+			Weapons: weapons(s.rulesets[name]),
+		})
+	}
+	return resp, nil
+}
+
+// weapons describes the weapons of the ruleset.
+func weapons(r game.Ruleset) []*pb.Weapon {
+	ws := make([]*pb.Weapon, 0, len(r.Weapons()))
+	for _, a := range r.Weapons() {
+		w := &pb.Weapon{
+			Choise: a,
+			Name:   r.WeaponName(a),
+		}
+		for _, b := range r.Weapons() {
+			if r.Beats(a, b) {
+				w.Beats = append(w.Beats, b)
+			}
+		}
+		ws = append(ws, w)
+	}
+	return ws
+}
@@ -21,14 +21,24 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"sync"
 	"time"
 
+	"github.com/movaua/rock-paper-scissors/pkg/account"
+	"github.com/movaua/rock-paper-scissors/pkg/game"
+	"github.com/movaua/rock-paper-scissors/pkg/matchmaking"
+	"github.com/movaua/rock-paper-scissors/pkg/rating"
 	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+	"github.com/movaua/rock-paper-scissors/pkg/stats"
+	"github.com/movaua/rock-paper-scissors/pkg/token"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // startCmd represents the start command
@@ -43,17 +53,148 @@ func init() {
 
 	startCmd.Flags().IntVarP(&port, "port", "p", 9090, "game server port")
 	startCmd.Flags().IntVarP(&timeoutSeconds, "timeout", "t", 10, "player answer timeout, seconds")
+	startCmd.Flags().IntVar(&minPlayers, "min-players", 2, "number of ready players needed to start the game")
+	startCmd.Flags().IntVar(&maxPlayers, "max-players", 0, "maximum number of players in the game, 0 means unlimited")
+	startCmd.Flags().StringVarP(&rulesetName, "ruleset", "r", game.Classic.Name(), fmt.Sprintf("game ruleset, one of %v or a custom ruleset from the config file", game.Builtin().Names()))
+	startCmd.Flags().StringVar(&policyName, "policy", game.Draw.Name(), fmt.Sprintf("multi-player round policy, one of %v", game.PolicyNames()))
+	startCmd.Flags().IntVar(&bestOf, "best-of", 0, "game ends after the number of rounds or as soon as the leader cannot be caught up")
+	startCmd.Flags().IntVar(&firstTo, "first-to", 0, "game ends when a player gets the number of points")
+	startCmd.Flags().IntVar(&fixedRounds, "rounds", 0, "game ends after the number of rounds")
+	startCmd.Flags().BoolVar(&suddenDeath, "sudden-death", false, "game goes on until the tie of the leaders is broken")
+	startCmd.Flags().BoolVar(&fairPlay, "fair-play", false, "players commit to their choises before revealing them")
+	startCmd.Flags().Duration("reconnect-timeout", 30*time.Second, "time for the players who drop out of a started game to come back before they forfeit it")
+	startCmd.Flags().IntVar(&matchTimeoutSeconds, "match-timeout", 60, "time to wait for a match in the matchmaking queue, seconds")
+	startCmd.Flags().StringVar(&accountsFile, "accounts", "", "file to keep the player accounts in, memory only if it is not set")
+	startCmd.Flags().StringVar(&dataFile, "data", "", "file to keep the ratings and the game history in, memory only if it is not set")
+	startCmd.Flags().String("rating-system", rating.Elo{}.Name(), fmt.Sprintf("player rating system, one of %v", ratingSystems))
+	startCmd.Flags().Float64("elo-k", 32, "maximum Elo rating change per game")
+	startCmd.Flags().Float64("glicko-tau", 0.5, "Glicko-2 constraint of the volatility change")
+	startCmd.Flags().Duration("glicko-period", 0, "Glicko-2 rating period of the idle players, 0 means the ratings do not decay")
+	startCmd.Flags().String("token-secret", "", "secret to sign the session tokens, a random one valid until the server restarts if it is not set")
+	startCmd.Flags().Duration("token-ttl", 24*time.Hour, "session token lifetime")
+	startCmd.Flags().String("tls-cert", "", "TLS certificate file, the server is plaintext if it is not set")
+	startCmd.Flags().String("tls-key", "", "TLS private key file")
+	startCmd.Flags().String("client-ca", "", "CA certificate file to verify the required client certificates, whose common names are the player names, a player can seat bots named <name>-bot-<n>")
+	startCmd.Flags().Bool("require-credentials", false, "require a password or a public key to register a player")
+	startCmd.Flags().Float64("rating-window", 100, "rating difference of the players matched right away")
+	startCmd.Flags().Float64("rating-window-growth", 10, "rating difference added per second of waiting for a match")
+	startCmd.Flags().Float64("rating-spread", 400, "maximum rating difference of the matched players, 0 means any")
+
+	for _, name := range []string{"reconnect-timeout", "rating-window", "rating-window-growth", "rating-spread", "rating-system", "elo-k", "glicko-tau", "glicko-period", "token-secret", "token-ttl", "require-credentials", "tls-cert", "tls-key", "client-ca"} {
+		if err := viper.BindPFlag(name, startCmd.Flags().Lookup(name)); err != nil {
+			panic(err)
+		}
+	}
 }
 
 var (
 	port           int
 	timeoutSeconds int
+	minPlayers     int
+	maxPlayers     int
+	rulesetName    string
+	policyName     string
+	bestOf         int
+	firstTo        int
+	fixedRounds    int
+	suddenDeath    bool
+	fairPlay       bool
+
+	matchTimeoutSeconds int
+
+	accountsFile string
+	dataFile     string
 )
 
 func startServer(cmd *cobra.Command, args []string) error {
 	// cmd.SilenceUsage = true
 
+	rulesets, err := loadRulesets()
+	if err != nil {
+		return err
+	}
+
+	defaults := &pb.RoomSettings{
+		Ruleset:              rulesetName,
+		Policy:               policyName,
+		ChoiseTimeoutSeconds: int32(timeoutSeconds),
+		MinPlayers:           int32(minPlayers),
+		MaxPlayers:           int32(maxPlayers),
+		BestOf:               int32(bestOf),
+		FirstTo:              int32(firstTo),
+		Rounds:               int32(fixedRounds),
+		SuddenDeath:          suddenDeath,
+		FairPlay:             fairPlay,
+	}
+
+	reconnectTimeout := viper.GetDuration("reconnect-timeout")
+	if reconnectTimeout < 0 {
+		return fmt.Errorf("reconnect timeout must not be negative, got %v", reconnectTimeout)
+	}
+
+	if matchTimeoutSeconds <= 0 {
+		return fmt.Errorf("match timeout must be positive, got %d", matchTimeoutSeconds)
+	}
+
+	window := matchmaking.Window{
+		Initial: viper.GetFloat64("rating-window"),
+		Growth:  viper.GetFloat64("rating-window-growth"),
+		Max:     viper.GetFloat64("rating-spread"),
+	}
+	if window.Initial < 0 || window.Growth < 0 || window.Max < 0 {
+		return fmt.Errorf("rating window settings must not be negative")
+	}
+
+	ratingSystem, err := newRatingSystem()
+	if err != nil {
+		return err
+	}
+
+	tokens, err := newTokenSigner()
+	if err != nil {
+		return err
+	}
+
+	creds, err := serverCredentials()
+	if err != nil {
+		return err
+	}
+
+	var accounts account.Store = account.NewMemory()
+	if accountsFile != "" {
+		if accounts, err = account.OpenFile(accountsFile); err != nil {
+			return err
+		}
+	}
+
+	store, err := stats.Open(dataFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gameServer, err := newGameServer(ctx, serverConfig{
+		defaults:           defaults,
+		reconnectTimeout:   reconnectTimeout,
+		rulesets:           rulesets,
+		matchTimeout:       time.Duration(matchTimeoutSeconds) * time.Second,
+		window:             window,
+		accounts:           accounts,
+		tokens:             tokens,
+		requireCredentials: viper.GetBool("require-credentials"),
+		ratings:            ratingSystem,
+		stats:              store,
+	})
+	if err != nil {
+		return err
+	}
+
 	fmt.Printf("Player answer timeout is %d seconds\n", timeoutSeconds)
+	fmt.Printf("Game ruleset is %s, round policy is %s\n", rulesetName, policyName)
+	fmt.Printf("Game starts with %d ready players\n", minPlayers)
+	fmt.Printf("Player rating system is %s\n", ratingSystem.Name())
 
 	addr := fmt.Sprintf(":%d", port)
 	fmt.Printf("starting game server at %s\n", addr)
@@ -65,48 +206,185 @@ func startServer(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("listening  %q\n", addr)
 
-	var opts []grpc.ServerOption
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(gameServer.authUnary),
+		grpc.StreamInterceptor(gameServer.authStream),
+	}
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+		fmt.Println("serving TLS")
+		if viper.GetString("client-ca") != "" {
+			fmt.Println("client certificates are required")
+		}
+	}
 
 	grpcServer := grpc.NewServer(opts...)
 
-	gameServer := newGameServer(timeoutSeconds)
-
 	pb.RegisterGamerServer(grpcServer, gameServer)
 
 	return grpcServer.Serve(lis)
 }
 
+// serverConfig is the configuration of the game server.
+type serverConfig struct {
+	defaults           *pb.RoomSettings // settings of the default room and unset settings of created rooms
+	reconnectTimeout   time.Duration
+	rulesets           game.Rulesets
+	matchTimeout       time.Duration
+	window             matchmaking.Window
+	accounts           account.Store
+	tokens             *token.Signer
+	requireCredentials bool
+	ratings            rating.System
+	stats              *stats.Store
+}
+
 type gameServer struct {
 	pb.UnimplementedGamerServer
-	answerTimeout time.Duration
-	playersMu     sync.Mutex // protects players
-	players       []*pb.Player
-	round         int
+	serverConfig
+	ctx              context.Context       // done when the server stops
+	mu               sync.Mutex            // protects all the fields below
+	players          map[string]*pb.Player // authenticated players by ID
+	challenges       map[string]challenge  // challenges to sign by challenge ID
+	rooms            map[string]*room
+	playerRooms      map[string]*room // the room of each player who joined one
+	defaultRoom      *room
+	lastRoomID       int
+	queue            matchmaking.Queue
+	waiters          map[string]*waiter // players in the matchmaking queue by ID
+	tournaments      map[string]*contest
+	lastTournamentID int
 }
 
-func newGameServer(answerTimeoutSeconds int) *gameServer {
-	return &gameServer{
-		answerTimeout: time.Duration(answerTimeoutSeconds) * time.Second,
+// newGameServer creates a new game server with the default room,
+// the server runs its background work until the context is done.
+func newGameServer(ctx context.Context, cfg serverConfig) (*gameServer, error) {
+	s := &gameServer{
+		serverConfig: cfg,
+		ctx:          ctx,
+		players:      make(map[string]*pb.Player),
+		challenges:   make(map[string]challenge),
+		rooms:        make(map[string]*room),
+		playerRooms:  make(map[string]*room),
+		queue:        matchmaking.Queue{Window: cfg.window},
+		waiters:      make(map[string]*waiter),
+		tournaments:  make(map[string]*contest),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.openRoom("default", nil)
+	if err != nil {
+		return nil, err
 	}
+	s.defaultRoom = r
+
+	go s.matchLoop(ctx)
+
+	return s, nil
 }
 
-func (s *gameServer) Auth(ctx context.Context, r *pb.AuthRequest) (*pb.AuthResponse, error) {
-	s.playersMu.Lock()
-	defer s.playersMu.Unlock()
+// Ready marks the player as ready in the room and blocks until min players are ready to start the game.
+// The player joins the default room if the room is not set.
+func (s *gameServer) Ready(ctx context.Context, req *pb.ReadyRequest) (*pb.ReadyResponse, error) {
+	var (
+		r   *room
+		err error
+	)
+	if req.GetRoomId() == "" {
+		r, err = s.enterRoom(req.GetPlayerId(), "")
+	} else {
+		r, err = s.findRoom(req.GetRoomId())
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	player := &pb.Player{
-		Name: r.GetName(),
-		Id:   fmt.Sprintf("%d", len(s.players)+1),
+	if err := r.markReady(req.GetPlayerId()); err != nil {
+		return nil, err
 	}
 
-	s.players = append(s.players, player)
+	select {
+	case <-r.quorum:
+	case <-r.abandoned:
+		return nil, status.Errorf(codes.Aborted, "room %q is abandoned", r.id)
+	case <-ctx.Done():
+		s.withdraw(req.GetPlayerId(), r)
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
 
-	return &pb.AuthResponse{
-		Id: player.Id,
+	return &pb.ReadyResponse{
+		ChoiseTimeoutSeconds: r.settings.GetChoiseTimeoutSeconds(),
+		Ruleset:              r.ruleset.Name(),
+		Weapons:              weapons(r.ruleset),
+		FairPlay:             r.fairPlay,
 	}, nil
 }
 
-func (s *gameServer) Ready(ctx context.Context, r *pb.ReadyRequest) (*pb.ReadyResponse, error) {
-	return nil, nil
+// Play joins the player to the game in the room.
+// The first message identifies the player and the room and may already carry a choise,
+// every next message is the player's choise for the current round.
+// The server sends the score to every connected player after each round
+// and closes the stream after the final score when the game is over.
+// The players who drop out of the game have the reconnect timeout to come back,
+// otherwise they forfeit the game. The stream fails with ABORTED if the room is abandoned.
+func (s *gameServer) Play(playSrv pb.Gamer_PlayServer) error {
+	first, err := playSrv.Recv()
+	if err != nil {
+		return err
+	}
+
+	playerID := playerFromContext(playSrv.Context())
+
+	r, err := s.findRoom(first.GetRoomId())
+	if err != nil {
+		return err
+	}
+
+	conn, err := r.join(playerID)
+	if err != nil {
+		return err
+	}
+	defer r.leave(playerID, conn)
+
+	r.play(playerID, first)
+
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			c, err := playSrv.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			r.play(playerID, c)
+		}
+	}()
+
+	for {
+		select {
+		case score := <-conn.scores:
+			if err := playSrv.Send(score); err != nil {
+				return err
+			}
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case <-r.over:
+			if r.finalScore() == nil {
+				return status.Errorf(codes.Aborted, "room %q is abandoned", r.id)
+			}
+			return nil
+		case <-conn.kicked:
+			return status.Errorf(codes.Aborted, "player %q left room %q", playerID, r.id)
+		}
+	}
+}
+
+// findPlayer returns the player by its ID or nil. mu must be held.
+func (s *gameServer) findPlayer(playerID string) *pb.Player {
+	return s.players[playerID]
 }
-func (s *gameServer) Play(playSrv pb.Gamer_PlayServer) error { return nil }
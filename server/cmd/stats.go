@@ -0,0 +1,178 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/account"
+	"github.com/movaua/rock-paper-scissors/pkg/rating"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+	"github.com/movaua/rock-paper-scissors/pkg/stats"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetPlayerStats returns the rating and the game history of a player.
+func (s *gameServer) GetPlayerStats(ctx context.Context, req *pb.GetPlayerStatsRequest) (*pb.PlayerStats, error) {
+	s.mu.Lock()
+	player := s.findPlayer(req.GetPlayerId())
+	s.mu.Unlock()
+
+	if player == nil {
+		a, err := s.accounts.Get(req.GetPlayerId())
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "player %q is not found", req.GetPlayerId())
+		}
+		r := s.rating(a.ID)
+		player = &pb.Player{
+			Id:              a.ID,
+			Name:            a.Name,
+			Rating:          r.Value,
+			RatingDeviation: r.Deviation,
+		}
+	}
+
+	ps := &pb.PlayerStats{
+		Player: player,
+	}
+
+	games := s.stats.Games(player.GetId())
+	for i := len(games) - 1; i >= 0; i-- {
+		g := games[i]
+		res, _ := g.Result(player.GetId())
+
+		ps.Games++
+		switch res.Status {
+		case pb.EnumStatus_Winner:
+			ps.Wins++
+		case pb.EnumStatus_Looser:
+			ps.Losses++
+		case pb.EnumStatus_Draw:
+			ps.Draws++
+		}
+
+		ps.History = append(ps.History, &pb.RatingChange{
+			RoomId:       g.Room,
+			GameId:       g.ID,
+			Ruleset:      g.Ruleset,
+			Finished:     timestamppb.New(g.Finished),
+			Score:        res.Score,
+			Status:       res.Status,
+			RatingBefore: res.Before.Value,
+			RatingAfter:  res.After.Value,
+		})
+	}
+
+	return ps, nil
+}
+
+// ratingSystems are the names of the supported rating systems.
+var ratingSystems = []string{rating.Elo{}.Name(), rating.Glicko2{}.Name()}
+
+// newRatingSystem creates the rating system set by the config.
+func newRatingSystem() (rating.System, error) {
+	switch name := viper.GetString("rating-system"); name {
+	case rating.Elo{}.Name():
+		k := viper.GetFloat64("elo-k")
+		if k <= 0 {
+			return nil, fmt.Errorf("Elo K-factor must be positive, got %v", k)
+		}
+		return rating.Elo{K: k}, nil
+	case rating.Glicko2{}.Name():
+		tau := viper.GetFloat64("glicko-tau")
+		if tau <= 0 {
+			return nil, fmt.Errorf("Glicko-2 tau must be positive, got %v", tau)
+		}
+		period := viper.GetDuration("glicko-period")
+		if period < 0 {
+			return nil, fmt.Errorf("Glicko-2 rating period must not be negative, got %v", period)
+		}
+		return rating.Glicko2{Tau: tau, Period: period}, nil
+	default:
+		return nil, fmt.Errorf("rating system %q is not found, supported systems are %v", name, ratingSystems)
+	}
+}
+
+// rating returns the current rating of the player.
+// The rating decays since the player's last game if the rating system supports it.
+func (s *gameServer) rating(playerID string) rating.Rating {
+	return s.stats.Current(playerID, s.ratings, time.Now())
+}
+
+// recordGame rates the players of the room if its game is over and records the game.
+// The players who have forfeited the game lose to all the others whatever their scores are.
+func (s *gameServer) recordGame(r *room) {
+	final := r.finalScore()
+	if final == nil {
+		return
+	}
+
+	gameID, err := account.NewID()
+	if err != nil {
+		fmt.Println("cannot record game:", err)
+		return
+	}
+
+	g := &stats.Game{
+		ID:       gameID,
+		Room:     r.id,
+		Ruleset:  r.ruleset.Name(),
+		Finished: time.Now(),
+	}
+	before := make(map[string]rating.Rating, len(final.GetGameResults()))
+	for _, gr := range final.GetGameResults() {
+		id := gr.GetPlayer().GetId()
+		before[id] = s.rating(id)
+		g.Rounds = gr.GetRounds()
+		g.Results = append(g.Results, stats.Result{
+			Player:    id,
+			Score:     gr.GetScore(),
+			Status:    gr.GetStatus(),
+			Forfeited: gr.GetForfeited(),
+			Before:    before[id],
+		})
+	}
+
+	after := rating.Rate(s.ratings, before, g.Scores())
+	for i := range g.Results {
+		g.Results[i].After = after[g.Results[i].Player]
+	}
+
+	if err := s.stats.Record(g); err != nil {
+		fmt.Println("cannot record game:", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, a := range after {
+		if p, ok := s.players[id]; ok {
+			p = proto.Clone(p).(*pb.Player)
+			p.Rating = a.Value
+			p.RatingDeviation = a.Deviation
+			s.players[id] = p
+		}
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/movaua/rock-paper-scissors/pkg/game"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+func TestRecordGameForfeit(t *testing.T) {
+	s := newTestGameServer(t)
+
+	r := newRoom("1", "test", gameConfig{ruleset: game.Classic, policy: game.Draw, ending: game.Unlimited}, &pb.RoomSettings{})
+	r.final = &pb.Score{
+		GameResults: []*pb.GameResult{
+			{Player: alice, Score: 0, Status: pb.EnumStatus_Winner, Rounds: 2},
+			{Player: bob, Score: 2, Status: pb.EnumStatus_Looser, Rounds: 2, Forfeited: true},
+		},
+	}
+
+	s.recordGame(r)
+	s.recordGame(r)
+
+	if got := s.rating("a").Value; got <= 1500 {
+		t.Errorf("rating of alice = %v, want more than 1500", got)
+	}
+	if got := s.rating("b").Value; got >= 1500 {
+		t.Errorf("rating of bob who has forfeited = %v, want less than 1500", got)
+	}
+
+	games := s.stats.Games("b")
+	if len(games) != 2 {
+		t.Fatalf("games of bob = %d, want 2", len(games))
+	}
+	if games[0].ID == "" || games[0].ID == games[1].ID {
+		t.Errorf("game IDs = %q, %q, want unique ones", games[0].ID, games[1].ID)
+	}
+	if games[0].Room != "1" {
+		t.Errorf("game room = %q, want 1", games[0].Room)
+	}
+	if res, _ := games[0].Result("b"); !res.Forfeited || res.Score != 2 {
+		t.Errorf("result of bob = %+v, want the score 2 and a forfeit", res)
+	}
+}
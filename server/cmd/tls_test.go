@@ -0,0 +1,383 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/movaua/rock-paper-scissors/pkg/account"
+	"github.com/movaua/rock-paper-scissors/pkg/game"
+	"github.com/movaua/rock-paper-scissors/pkg/rating"
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+	"github.com/movaua/rock-paper-scissors/pkg/stats"
+	"github.com/movaua/rock-paper-scissors/pkg/token"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestServerCredentialsPlaintext(t *testing.T) {
+	setTLSConfig(t, "", "", "")
+
+	creds, err := serverCredentials()
+	if err != nil {
+		t.Fatalf("serverCredentials() = %v", err)
+	}
+	if creds != nil {
+		t.Fatalf("serverCredentials() = %v, want plaintext", creds.Info())
+	}
+
+	conn := startTestServer(t, nil, grpc.WithInsecure())
+	a, err := pb.NewGamerClient(conn).Auth(context.Background(), &pb.AuthRequest{Name: "alice"})
+	if err != nil {
+		t.Fatalf("Auth() over plaintext = %v", err)
+	}
+	if a.GetPlayer().GetName() != "alice" {
+		t.Errorf("Auth() player = %q, want alice", a.GetPlayer().GetName())
+	}
+}
+
+func TestServerCredentialsConfig(t *testing.T) {
+	pki := newTestPKI(t)
+
+	tests := []struct {
+		name             string
+		cert, key, ca    string
+		wantErr, wantTLS bool
+	}{
+		{name: "client CA only", ca: pki.caFile, wantErr: true},
+		{name: "certificate only", cert: pki.serverCert, wantErr: true},
+		{name: "key only", key: pki.serverKey, wantErr: true},
+		{name: "missing file", cert: pki.serverCert, key: filepath.Join(t.TempDir(), "none.pem"), wantErr: true},
+		{name: "bad client CA", cert: pki.serverCert, key: pki.serverKey, ca: pki.serverKey, wantErr: true},
+		{name: "TLS", cert: pki.serverCert, key: pki.serverKey, wantTLS: true},
+		{name: "mutual TLS", cert: pki.serverCert, key: pki.serverKey, ca: pki.caFile, wantTLS: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTLSConfig(t, tt.cert, tt.key, tt.ca)
+
+			creds, err := serverCredentials()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("serverCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (creds != nil) != tt.wantTLS {
+				t.Fatalf("serverCredentials() = %v, want TLS %v", creds, tt.wantTLS)
+			}
+		})
+	}
+}
+
+func TestMutualTLS(t *testing.T) {
+	pki := newTestPKI(t)
+	setTLSConfig(t, pki.serverCert, pki.serverKey, pki.caFile)
+
+	creds, err := serverCredentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dial := func(clientCerts ...tls.Certificate) pb.GamerClient {
+		conn := startTestServer(t, creds, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs:      pki.pool,
+			ServerName:   "localhost",
+			Certificates: clientCerts,
+		})))
+		return pb.NewGamerClient(conn)
+	}
+
+	t.Run("no client certificate", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if _, err := dial().Auth(ctx, &pb.AuthRequest{Name: "alice"}); err == nil {
+			t.Fatal("Auth() without a client certificate = nil error")
+		}
+	})
+
+	t.Run("client certificate identity", func(t *testing.T) {
+		c := dial(pki.client(t, "alice"))
+		ctx := context.Background()
+
+		a, err := c.Auth(ctx, &pb.AuthRequest{})
+		if err != nil {
+			t.Fatalf("Auth() = %v", err)
+		}
+		if a.GetPlayer().GetName() != "alice" {
+			t.Fatalf("Auth() player = %q, want the certificate name alice", a.GetPlayer().GetName())
+		}
+
+		again, err := c.Auth(ctx, &pb.AuthRequest{Name: "alice"})
+		if err != nil {
+			t.Fatalf("Auth() again = %v", err)
+		}
+		if again.GetId() != a.GetId() {
+			t.Errorf("Auth() again = player %q, want the same player %q", again.GetId(), a.GetId())
+		}
+
+		_, err = c.Auth(ctx, &pb.AuthRequest{Name: "bob"})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Auth() of another name = %v, want %v", err, codes.PermissionDenied)
+		}
+
+		// The certificate authenticates the calls without a session token.
+		r, err := c.CreateRoom(ctx, &pb.CreateRoomRequest{Name: "cert room"})
+		if err != nil {
+			t.Fatalf("CreateRoom() without a token = %v", err)
+		}
+		if len(r.GetPlayers()) != 1 || r.GetPlayers()[0].GetId() != a.GetId() {
+			t.Errorf("CreateRoom() players = %v, want alice", r.GetPlayers())
+		}
+	})
+
+	t.Run("bots of the certificate player", func(t *testing.T) {
+		c := dial(pki.client(t, "carol"))
+		ctx := context.Background()
+
+		owner, err := c.Auth(ctx, &pb.AuthRequest{})
+		if err != nil {
+			t.Fatalf("Auth() = %v", err)
+		}
+		b, err := c.Auth(ctx, &pb.AuthRequest{Name: "carol-bot-1", Reconnect: true})
+		if err != nil {
+			t.Fatalf("Auth() of a bot = %v", err)
+		}
+		if b.GetPlayer().GetName() != "carol-bot-1" || b.GetId() == owner.GetId() {
+			t.Fatalf("Auth() of a bot = player %q %q, want a new player carol-bot-1", b.GetId(), b.GetPlayer().GetName())
+		}
+
+		// The bot's token authenticates the calls as the bot.
+		botCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+b.GetToken())
+		r, err := c.CreateRoom(botCtx, &pb.CreateRoomRequest{Name: "bot room"})
+		if err != nil {
+			t.Fatalf("CreateRoom() of a bot = %v", err)
+		}
+		if len(r.GetPlayers()) != 1 || r.GetPlayers()[0].GetId() != b.GetId() {
+			t.Errorf("CreateRoom() players = %v, want carol-bot-1", r.GetPlayers())
+		}
+
+		for _, name := range []string{"dave-bot-1", "carol-bot-"} {
+			if _, err := c.Auth(ctx, &pb.AuthRequest{Name: name, Reconnect: true}); status.Code(err) != codes.PermissionDenied {
+				t.Errorf("Auth() of %s = %v, want %v", name, err, codes.PermissionDenied)
+			}
+		}
+	})
+}
+
+// setTLSConfig sets the TLS config of the server for the test.
+func setTLSConfig(t *testing.T, cert, key, ca string) {
+	t.Helper()
+	for k, v := range map[string]string{"tls-cert": cert, "tls-key": key, "client-ca": ca} {
+		viper.Set(k, v)
+	}
+	t.Cleanup(func() {
+		for _, k := range []string{"tls-cert", "tls-key", "client-ca"} {
+			viper.Set(k, "")
+		}
+	})
+}
+
+// newTestGameServer creates a game server of two-player classic games
+// with the config changed by the options, it stops when the test ends.
+func newTestGameServer(t *testing.T, opts ...func(*serverConfig)) *gameServer {
+	t.Helper()
+
+	store, err := stats.Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := serverConfig{
+		defaults: &pb.RoomSettings{
+			Ruleset:              game.Classic.Name(),
+			Policy:               game.Draw.Name(),
+			ChoiseTimeoutSeconds: 1,
+			MinPlayers:           2,
+		},
+		rulesets:     game.Builtin(),
+		matchTimeout: time.Minute,
+		accounts:     account.NewMemory(),
+		tokens:       token.NewSigner([]byte("secret"), time.Hour),
+		ratings:      rating.Elo{K: 32},
+		stats:        store,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	s, err := newGameServer(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// startTestServer starts an in-process game server with the credentials, nil means plaintext,
+// and returns a client connection to it.
+func startTestServer(t *testing.T, creds credentials.TransportCredentials, opts ...grpc.DialOption) *grpc.ClientConn {
+	t.Helper()
+
+	s := newTestGameServer(t)
+
+	serverOpts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(s.authUnary),
+		grpc.StreamInterceptor(s.authStream),
+	}
+	if creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+	srv := grpc.NewServer(serverOpts...)
+	pb.RegisterGamerServer(srv, s)
+
+	lis := bufconn.Listen(1 << 20)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	conn, err := grpc.Dial("bufnet", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+// testPKI is a CA with a server certificate generated for a test.
+type testPKI struct {
+	ca         *x509.Certificate
+	caKey      *ecdsa.PrivateKey
+	pool       *x509.CertPool
+	caFile     string
+	serverCert string
+	serverKey  string
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey := newKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pki := &testPKI{
+		ca:     ca,
+		caKey:  caKey,
+		pool:   x509.NewCertPool(),
+		caFile: filepath.Join(dir, "ca.pem"),
+	}
+	pki.pool.AddCert(ca)
+	writePEM(t, pki.caFile, "CERTIFICATE", caDER)
+
+	server := pki.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+	pki.serverCert = filepath.Join(dir, "server.pem")
+	pki.serverKey = filepath.Join(dir, "server-key.pem")
+	writePEM(t, pki.serverCert, "CERTIFICATE", server.Certificate[0])
+	keyDER, err := x509.MarshalECPrivateKey(server.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, pki.serverKey, "EC PRIVATE KEY", keyDER)
+
+	return pki
+}
+
+// client issues a client certificate of the player name.
+func (pki *testPKI) client(t *testing.T, name string) tls.Certificate {
+	return pki.issue(t, name, x509.ExtKeyUsageClientAuth)
+}
+
+// issue issues a certificate of the common name signed by the CA.
+func (pki *testPKI) issue(t *testing.T, name string, usage x509.ExtKeyUsage) tls.Certificate {
+	t.Helper()
+
+	key := newKey(t)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}, pki.ca, &key.PublicKey, pki.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func writePEM(t *testing.T, path, typ string, der []byte) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
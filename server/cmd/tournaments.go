@@ -0,0 +1,517 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+	"github.com/movaua/rock-paper-scissors/pkg/tournament"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// contest is a tournament run by the server.
+// All its fields are protected by the server mu.
+type contest struct {
+	id           string
+	name         string
+	owner        string // ID of the player who created the tournament
+	format       tournament.Format
+	settings     *pb.RoomSettings // settings of the match rooms
+	readyTimeout time.Duration
+	players      []string               // IDs of the registered players
+	t            *tournament.Tournament // nil until the tournament is started
+	rooms        map[int]*room          // rooms of the matches in progress by match ID
+}
+
+// CreateTournament creates a tournament open for registration.
+// Only the creating player can be registered right away.
+func (s *gameServer) CreateTournament(ctx context.Context, req *pb.CreateTournamentRequest) (*pb.Tournament, error) {
+	formatName := req.GetFormat()
+	if formatName == "" {
+		formatName = tournament.RoundRobin.Name()
+	}
+	format, err := tournament.LookupFormat(formatName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if rounds := req.GetRounds(); rounds != 0 {
+		if format.Name() != tournament.Swiss(0).Name() {
+			return nil, status.Errorf(codes.InvalidArgument, "rounds can only be set for a %s tournament", tournament.Swiss(0).Name())
+		}
+		if rounds < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "rounds must not be negative, got %d", rounds)
+		}
+		format = tournament.Swiss(int(rounds))
+	}
+
+	readyTimeout := s.matchTimeout
+	if req.GetReadyTimeoutSeconds() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ready timeout must not be negative, got %d", req.GetReadyTimeoutSeconds())
+	}
+	if req.GetReadyTimeoutSeconds() > 0 {
+		readyTimeout = time.Duration(req.GetReadyTimeoutSeconds()) * time.Second
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	settings, err := s.matchSettings(req.GetSettings())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c := &contest{
+		name:         req.GetName(),
+		owner:        req.GetPlayerId(),
+		format:       format,
+		settings:     settings,
+		readyTimeout: readyTimeout,
+		rooms:        make(map[int]*room),
+	}
+
+	for _, id := range req.GetPlayerIds() {
+		if id != c.owner {
+			return nil, status.Errorf(codes.PermissionDenied, "player %q can only register itself", c.owner)
+		}
+		if err := s.register(c, id); err != nil {
+			return nil, err
+		}
+	}
+
+	s.lastTournamentID++
+	c.id = strconv.Itoa(s.lastTournamentID)
+	s.tournaments[c.id] = c
+
+	return s.tournamentInfo(c), nil
+}
+
+// JoinTournament registers the player in the tournament.
+func (s *gameServer) JoinTournament(ctx context.Context, req *pb.JoinTournamentRequest) (*pb.Tournament, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.findTournament(req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+	if c.t != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "tournament %q has already started", c.id)
+	}
+
+	if err := s.register(c, req.GetPlayerId()); err != nil {
+		return nil, err
+	}
+
+	return s.tournamentInfo(c), nil
+}
+
+// StartTournament closes the registration and starts the matches of the tournament.
+// Only the owner can start the tournament. The players are seeded by their ratings.
+func (s *gameServer) StartTournament(ctx context.Context, req *pb.StartTournamentRequest) (*pb.Tournament, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.findTournament(req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+	if c.owner != req.GetPlayerId() {
+		return nil, status.Errorf(codes.PermissionDenied, "player %q is not the owner of tournament %q", req.GetPlayerId(), c.id)
+	}
+	if c.t != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "tournament %q has already started", c.id)
+	}
+
+	seeds := append([]string(nil), c.players...)
+	sort.SliceStable(seeds, func(i, j int) bool {
+		return s.findPlayer(seeds[i]).GetRating() > s.findPlayer(seeds[j]).GetRating()
+	})
+
+	t, err := tournament.New(c.format, seeds)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	c.t = t
+
+	s.scheduleMatches(c)
+
+	return s.tournamentInfo(c), nil
+}
+
+// GetTournament returns the tournament with its matches and standings.
+func (s *gameServer) GetTournament(ctx context.Context, req *pb.GetTournamentRequest) (*pb.Tournament, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.findTournament(req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+	return s.tournamentInfo(c), nil
+}
+
+// ListTournaments lists the tournaments.
+func (s *gameServer) ListTournaments(ctx context.Context, req *pb.ListTournamentsRequest) (*pb.ListTournamentsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contests := make([]*contest, 0, len(s.tournaments))
+	for _, c := range s.tournaments {
+		contests = append(contests, c)
+	}
+	sort.Slice(contests, func(i, j int) bool {
+		a, _ := strconv.Atoi(contests[i].id)
+		b, _ := strconv.Atoi(contests[j].id)
+		return a < b
+	})
+
+	resp := &pb.ListTournamentsResponse{}
+	for _, c := range contests {
+		resp.Tournaments = append(resp.Tournaments, s.tournamentInfo(c))
+	}
+	return resp, nil
+}
+
+// matchSettings returns the effective settings of the tournament match rooms. mu must be held.
+func (s *gameServer) matchSettings(settings *pb.RoomSettings) (*pb.RoomSettings, error) {
+	_, st, err := s.roomConfig(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	st.MinPlayers = 2
+	st.MaxPlayers = 2
+	if st.GetBestOf() == 0 && st.GetFirstTo() == 0 && st.GetRounds() == 0 {
+		st.BestOf = 3
+	}
+
+	if _, _, err := s.roomConfig(st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// register adds the player to the tournament. mu must be held.
+func (s *gameServer) register(c *contest, playerID string) error {
+	if s.findPlayer(playerID) == nil {
+		return status.Errorf(codes.NotFound, "player %q is not found", playerID)
+	}
+	for _, id := range c.players {
+		if id == playerID {
+			return status.Errorf(codes.AlreadyExists, "player %q is already registered in tournament %q", playerID, c.id)
+		}
+	}
+
+	c.players = append(c.players, playerID)
+	return nil
+}
+
+// findTournament returns the tournament by its ID. mu must be held.
+func (s *gameServer) findTournament(id string) (*contest, error) {
+	c, ok := s.tournaments[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "tournament %q is not found", id)
+	}
+	return c, nil
+}
+
+// scheduleMatches opens a room for every scheduled match whose players are not playing
+// another match of the tournament, so that independent matches are played in parallel.
+// A player who is in a game of another room stays there:
+// the player can get ready in the match room by its ID or forfeits the match. mu must be held.
+func (s *gameServer) scheduleMatches(c *contest) {
+	busy := make(map[string]bool)
+	for id := range c.rooms {
+		for _, p := range c.t.Match(id).Players {
+			busy[p] = true
+		}
+	}
+
+	for _, m := range c.t.Pending() {
+		if _, ok := c.rooms[m.ID]; ok || busy[m.Players[0]] || busy[m.Players[1]] {
+			continue
+		}
+
+		r, err := s.openRoom(fmt.Sprintf("%s #%d", c.name, m.ID), c.settings)
+		if err != nil {
+			fmt.Printf("cannot open room of match %d of tournament %q: %v\n", m.ID, c.id, err)
+			continue
+		}
+
+		for _, id := range m.Players {
+			if err := r.enter(s.findPlayer(id)); err != nil {
+				fmt.Printf("cannot enter room of match %d of tournament %q: %v\n", m.ID, c.id, err)
+			}
+			busy[id] = true
+			if cur, ok := s.playerRooms[id]; ok && !isClosed(cur.over) {
+				continue
+			}
+			s.playerRooms[id] = r
+		}
+
+		c.rooms[m.ID] = r
+		go s.playMatch(c, m.ID, r)
+	}
+}
+
+// playMatch waits for the match in the room to finish and reports its result.
+// The players who are not ready within the ready timeout forfeit the match,
+// so do the players who do not connect to the game within the ready timeout after that.
+// Once the game has started, the room forfeits the players who drop out of it
+// and do not come back within the reconnect timeout. It stops waiting when the server stops.
+func (s *gameServer) playMatch(c *contest, matchID int, r *room) {
+	timer := time.NewTimer(c.readyTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-r.quorum:
+	case <-r.abandoned:
+	case <-timer.C:
+		if ready, ok := r.cancel(); ok {
+			s.finishMatch(c, matchID, nil, ready)
+			return
+		}
+	}
+
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	timer.Reset(c.readyTimeout)
+
+	select {
+	case <-r.connected:
+	case <-r.over:
+	case <-timer.C:
+		if connected, ok := r.abort(); ok {
+			s.finishMatch(c, matchID, nil, connected)
+			return
+		}
+	}
+
+	select {
+	case <-r.over:
+	case <-s.ctx.Done():
+		return
+	}
+	s.finishMatch(c, matchID, r.finalScore(), nil)
+}
+
+// finishMatch reports the result of the match and schedules the next matches.
+// The match with no final score is forfeited by the players who are not present,
+// the match whose game has been forfeited is won by default by the player who has not forfeited it.
+func (s *gameServer) finishMatch(c *contest, matchID int, final *pb.Score, present []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(c.rooms, matchID)
+	m := c.t.Match(matchID)
+
+	forfeited := false
+	for _, gr := range final.GetGameResults() {
+		if gr.GetForfeited() {
+			forfeited = true
+		} else {
+			present = append(present, gr.GetPlayer().GetId())
+		}
+	}
+
+	var err error
+	if final == nil || forfeited {
+		winner := -1
+		if len(present) == 1 {
+			winner = 0
+			if m.Players[1] == present[0] {
+				winner = 1
+			}
+		}
+		err = c.t.Forfeit(matchID, winner)
+	} else {
+		var scores [2]int32
+		for _, gr := range final.GetGameResults() {
+			for i, id := range m.Players {
+				if gr.GetPlayer().GetId() == id {
+					scores[i] = gr.GetScore()
+				}
+			}
+		}
+		err = c.t.Report(matchID, scores)
+	}
+	if err != nil {
+		fmt.Printf("cannot finish match %d of tournament %q: %v\n", matchID, c.id, err)
+		return
+	}
+
+	s.scheduleMatches(c)
+}
+
+// GetBracket returns the matches of the tournament as a tree
+// where the children of a match are the matches whose winners play it.
+func (s *gameServer) GetBracket(ctx context.Context, req *pb.GetTournamentRequest) (*pb.Bracket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.findTournament(req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+	if c.t == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "tournament %q has not started yet", c.id)
+	}
+
+	advanced := make(map[int]bool)
+	for _, m := range c.t.Matches() {
+		for _, src := range m.Sources {
+			if src != nil && !src.Loser {
+				advanced[src.Match] = true
+			}
+		}
+	}
+
+	var node func(m *tournament.Match) *pb.BracketNode
+	node = func(m *tournament.Match) *pb.BracketNode {
+		n := &pb.BracketNode{
+			Match: s.matchInfo(c, m),
+		}
+		for _, src := range m.Sources {
+			if src != nil && !src.Loser {
+				n.Children = append(n.Children, node(c.t.Match(src.Match)))
+			}
+		}
+		return n
+	}
+
+	b := &pb.Bracket{
+		TournamentId: c.id,
+	}
+	for _, m := range c.t.Matches() {
+		if !advanced[m.ID] {
+			b.Roots = append(b.Roots, node(m))
+		}
+	}
+	return b, nil
+}
+
+// tournamentInfo describes the tournament. mu must be held.
+func (s *gameServer) tournamentInfo(c *contest) *pb.Tournament {
+	info := &pb.Tournament{
+		Id:                  c.id,
+		Name:                c.name,
+		Format:              c.format.Name(),
+		Settings:            c.settings,
+		State:               pb.EnumTournamentState_Registering,
+		ReadyTimeoutSeconds: int32(c.readyTimeout / time.Second),
+		Owner:               s.tournamentPlayer(c.owner),
+	}
+
+	if c.t == nil {
+		for _, id := range c.players {
+			info.Players = append(info.Players, s.tournamentPlayer(id))
+		}
+		return info
+	}
+
+	info.State = pb.EnumTournamentState_Running
+	if c.t.Over() {
+		info.State = pb.EnumTournamentState_Finished
+	}
+
+	for _, id := range c.t.Players() {
+		info.Players = append(info.Players, s.tournamentPlayer(id))
+	}
+
+	for _, m := range c.t.Matches() {
+		info.Matches = append(info.Matches, s.matchInfo(c, m))
+	}
+
+	for i, st := range c.t.Standings() {
+		info.Standings = append(info.Standings, &pb.TournamentStanding{
+			Rank:         int32(i + 1),
+			Player:       s.tournamentPlayer(st.Player),
+			Played:       int32(st.Played),
+			Wins:         int32(st.Wins),
+			Draws:        int32(st.Draws),
+			Losses:       int32(st.Losses),
+			Points:       st.Points,
+			Differential: st.Differential,
+			Buchholz:     st.Buchholz,
+		})
+	}
+
+	return info
+}
+
+// matchInfo describes the tournament match. mu must be held.
+func (s *gameServer) matchInfo(c *contest, m *tournament.Match) *pb.TournamentMatch {
+	tm := &pb.TournamentMatch{
+		Id:      int32(m.ID),
+		Round:   int32(m.Round),
+		Players: []*pb.Player{s.tournamentPlayer(m.Players[0]), s.tournamentPlayer(m.Players[1])},
+		State:   pb.EnumRoomState_Waiting,
+		Bracket: pb.EnumBracket(m.Bracket),
+	}
+	for _, src := range m.Sources {
+		ms := &pb.MatchSource{}
+		if src != nil {
+			ms.MatchId = int32(src.Match)
+			ms.Loser = src.Loser
+		}
+		tm.Sources = append(tm.Sources, ms)
+	}
+	if r, ok := c.rooms[m.ID]; ok {
+		tm.RoomId = r.id
+		tm.State = r.info().GetState()
+	}
+	if m.Done {
+		tm.State = pb.EnumRoomState_Over
+		tm.Scores = m.Scores[:]
+		tm.Forfeit = m.Forfeit
+		if m.Winner >= 0 {
+			tm.WinnerId = m.Players[m.Winner]
+		}
+	}
+	return tm
+}
+
+// tournamentPlayer returns the player by its ID,
+// an empty player for a bye or a player who is not known yet. mu must be held.
+func (s *gameServer) tournamentPlayer(id string) *pb.Player {
+	if p := s.findPlayer(id); p != nil {
+		return p
+	}
+	return &pb.Player{}
+}
+
+// isClosed reports whether the channel is closed.
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright © 2020 Valeriy Molchanov <valeriy.molchanov.77@gmail.com>
+
+This program is free software; you can redistribute it and/or
+modify it under the terms of the GNU General Public License
+as published by the Free Software Foundation; either version 2
+of the License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU Lesser General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/movaua/rock-paper-scissors/pkg/rps"
+)
+
+func TestMatchDropOut(t *testing.T) {
+	s := newTestGameServer(t, func(cfg *serverConfig) {
+		cfg.reconnectTimeout = 100 * time.Millisecond
+	})
+	s.mu.Lock()
+	s.players[alice.GetId()] = alice
+	s.players[bob.GetId()] = bob
+	s.mu.Unlock()
+
+	ctx := context.Background()
+	tr, err := s.CreateTournament(ctx, &pb.CreateTournamentRequest{PlayerId: alice.GetId(), PlayerIds: []string{alice.GetId()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.JoinTournament(ctx, &pb.JoinTournamentRequest{PlayerId: bob.GetId(), TournamentId: tr.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.StartTournament(ctx, &pb.StartTournamentRequest{PlayerId: alice.GetId(), TournamentId: tr.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+
+	s.mu.Lock()
+	c := s.tournaments[tr.GetId()]
+	r := c.rooms[1]
+	s.mu.Unlock()
+	if r == nil {
+		t.Fatal("no room is opened for the match")
+	}
+
+	conns := seat(t, r, alice, bob)
+	go func() {
+		for {
+			select {
+			case <-conns[alice.GetId()].scores:
+			case <-r.over:
+				return
+			}
+		}
+	}()
+
+	// bob drops out of the game and never comes back
+	r.leave(bob.GetId(), conns[bob.GetId()])
+	waitClosed(t, r.over, "game of the match is not over")
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.mu.Lock()
+		m := *c.t.Match(1)
+		s.mu.Unlock()
+		if m.Done {
+			if !m.Forfeit || m.Winner < 0 || m.Players[m.Winner] != alice.GetId() {
+				t.Errorf("match = %+v, want alice to win by default", m)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("match is not finished")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}